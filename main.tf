@@ -28,15 +28,8 @@ resource "app_tile" "anxiety" {
   name           = var.name
   description    = var.description
   image          = "icon-240.png"
-  image_hash     = filemd5("./icon-240.png")
   app_tile_id    = var.app_tile_id
   auto_version   = true
-  lifecycle {
-    ignore_changes = [
-      image,
-      version,
-    ]
-  }
 }
 
 resource "app_tile" "second_test" {
@@ -44,13 +37,7 @@ resource "app_tile" "second_test" {
   name           = "Test Terraform Module"
   description    = "Simple test stuff"
   image          = "icon-240.png"
-  image_hash     = filemd5("./icon-240.png")
   app_tile_id    = var.app_tile_id
+  module_id_key  = "second_test"
   auto_version   = true
-  lifecycle {
-    ignore_changes = [
-      image,
-      version,
-    ]
-  }
 }
@@ -1,26 +1,65 @@
 package marketplace
 
 import (
-	"bytes"
 	"context"
 	"errors"
-	"io"
-	"mime/multipart"
-	"net/http"
-	"os"
-	"path"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
 
 	"github.com/Khan/genqlient/graphql"
+	"github.com/coreos/go-semver/semver"
 	"github.com/lifeomic/phc-sdk-go/client"
+	"github.com/vektah/gqlparser/v2/gqlerror"
 )
 
 //go:generate go run github.com/Khan/genqlient
 
 const GRAPHQL_URL = "marketplace-service:deployed/v1/marketplace/authenticated/graphql"
 
+// PAGE_SIZE is the number of edges requested per page when walking a connection.
+const PAGE_SIZE = 100
+
 type MarketplaceClient struct {
 	phcClient *client.LambdaClient
 	gqlClient graphql.Client
+	// Reject module tags that aren't in the tag catalog yet
+	strictTags bool
+	// Requirements enforced on module icons and preview images before upload
+	iconLimits    imageLimits
+	previewLimits imageLimits
+	// Derive app tile module ids from this seed when set
+	moduleIdSeed string
+	// Module ids derived from moduleIdSeed during this plan or apply
+	derivedModuleIds moduleIdClaims
+	// Serializes installs of the same module version, which can only be told apart by when they appear
+	installLocks keyedMutex
+	// Serializes replies to the same review, which are recognized as the reply that wasn't there before
+	replyLocks keyedMutex
+}
+
+// keyedMutex is a mutex per key, the zero value is ready to use
+type keyedMutex struct {
+	mutex sync.Mutex
+	locks map[string]*sync.Mutex
+}
+
+// lock locks key and returns the function that unlocks it again
+func (keyed *keyedMutex) lock(key string) func() {
+	keyed.mutex.Lock()
+	if keyed.locks == nil {
+		keyed.locks = map[string]*sync.Mutex{}
+	}
+	lock, ok := keyed.locks[key]
+	if !ok {
+		lock = &sync.Mutex{}
+		keyed.locks[key] = lock
+	}
+	keyed.mutex.Unlock()
+
+	lock.Lock()
+	return lock.Unlock
 }
 
 func (marketplace *MarketplaceClient) getAppTileModule(id string) (*AppTileModule, error) {
@@ -31,91 +70,135 @@ func (marketplace *MarketplaceClient) getAppTileModule(id string) (*AppTileModul
 	return &resp.MyModule.AppTileModule, nil
 }
 
+type previewImage struct {
+	Image       *preparedImage
+	Description string
+}
+
 type appTileCreate struct {
-	Name           string
-	Description    string
-	Image          string
-	AppTileId      string
-	Version        string
-	ParentModuleId *string
+	Name          string
+	Description   string
+	ModuleId      string         // Id for a new module, generated by the service when empty
+	Image         *preparedImage // nil when the module has no icon
+	RemoveIcon    bool           // Remove the icon the new version inherits from its parent
+	PreviewImages []previewImage
+	// Replace the preview images the new version inherits from its parent with PreviewImages
+	ReplacePreviewImages bool
+	AppTileId            string
+	Version              string
+	ParentModuleId       *string
+	Review               bool
+	Tags                 []string
+	Prices               []DraftModulePriceInput
 }
 
-func postImageToUrl(url string, image string, file_name string, fields map[string]string) error {
-	file, err := os.Open(image)
+func (marketplace *MarketplaceClient) attachImageToDraftModule(moduleId string, uploadType UploadType, image *preparedImage, description string, priority int) error {
+	startResponse, err := StartImageUpload(context.Background(), marketplace.gqlClient, StartUploadInput{
+		FileName: image.FileName,
+	})
 	if err != nil {
 		return err
 	}
 
-	body := &bytes.Buffer{}
-	writer := multipart.NewWriter(body)
-	for key, val := range fields {
-		err = writer.WriteField(key, val)
-		if err != nil {
-			return err
-		}
-	}
-	part, err := writer.CreateFormFile("file", file_name)
+	err = postImageToUrl(startResponse.StartUpload.Url, image.FileName, image.ContentType, image.Reader(), startResponse.StartUpload.Fields)
 	if err != nil {
 		return err
 	}
-	_, err = io.Copy(part, file)
+
+	finalizeResponse, err := FinalizeImageUpload(context.Background(), marketplace.gqlClient, FinalizeUploadInput{
+		Id:          startResponse.StartUpload.Id,
+		ModuleId:    moduleId,
+		Type:        uploadType,
+		Description: description,
+		Priority:    priority,
+	})
+
 	if err != nil {
 		return err
 	}
 
-	err = writer.Close()
-	if err != nil {
-		return err
+	if finalizeResponse == nil {
+		return errors.New("unable to finalize image upload")
 	}
 
-	req, err := http.NewRequest("POST", url, body)
-	req.Header.Set("Content-Type", writer.FormDataContentType())
+	return nil
+
+}
+
+// replaceDraftModulePreviewImages removes the preview images a draft inherited and uploads the new ones in order
+func (marketplace *MarketplaceClient) replaceDraftModulePreviewImages(draftModuleId string, images []previewImage) error {
+	resp, err := GetDraftModulePreviewImages(context.Background(), marketplace.gqlClient, draftModuleId)
 	if err != nil {
-		return err
+		return fmt.Errorf("failed to list the preview images: %w", err)
 	}
-	client := &http.Client{}
-	resp, err := client.Do(req)
-	if err != nil {
-		return err
+	if resp.DraftModule.PreviewImagesV2 != nil {
+		for _, inherited := range resp.DraftModule.PreviewImagesV2.Images {
+			if _, err := RemoveDraftModulePreviewImage(context.Background(), marketplace.gqlClient, RemoveDraftModulePreviewImagesV2Input{
+				ModuleId:      draftModuleId,
+				FileName:      inherited.FileName,
+				FileExtension: inherited.FileExtension,
+			}); err != nil {
+				return fmt.Errorf("failed to remove preview image %s: %w", inherited.FileName, err)
+			}
+		}
+	}
+
+	for priority, image := range images {
+		if err := marketplace.attachImageToDraftModule(draftModuleId, UploadTypePreviewImage, image.Image, image.Description, priority); err != nil {
+			return fmt.Errorf("failed to upload preview image %d: %w", priority+1, err)
+		}
 	}
-	responseBody := &bytes.Buffer{}
-	responseBody.ReadFrom(resp.Body)
-	resp.Body.Close()
 	return nil
 }
 
-func (marketplace *MarketplaceClient) attachImageToDraftModule(moduleId string, image string) error {
-	fileName := path.Base(image)
-	startResponse, err := StartImageUpload(context.Background(), marketplace.gqlClient, StartUploadInput{
-		FileName: fileName,
-	})
-	if err != nil {
-		return err
+// rollbackDraftModule deletes a draft left behind by a failed step so that a retry doesn't
+// leave another orphaned draft, and returns the error that caused it
+func (marketplace *MarketplaceClient) rollbackDraftModule(draftModuleId string, cause error) error {
+	if _, err := DeleteDraftModule(context.Background(), marketplace.gqlClient, draftModuleId); err != nil {
+		return fmt.Errorf("%w (deleting draft module %s also failed, delete it before trying again: %s)", cause, draftModuleId, err)
 	}
+	return cause
+}
+
+// completeAppTileDraftModule sets the source and icon of a new draft
+func (marketplace *MarketplaceClient) completeAppTileDraftModule(draftModuleId string, params appTileCreate) error {
+	appTileRes, err := SetAppTile(context.Background(), marketplace.gqlClient, SetPublicAppTileDraftModuleSourceInput{
+		ModuleId: draftModuleId,
+		SourceInfo: PublicAppTileModuleSourceInfo{
+			Id: params.AppTileId,
+		},
+	})
 
-	err = postImageToUrl(startResponse.StartUpload.Url, image, fileName, startResponse.StartUpload.Fields)
 	if err != nil {
 		return err
 	}
 
-	finalizeResponse, err := FinalizeImageUpload(context.Background(), marketplace.gqlClient, FinalizeUploadInput{
-		Id:       startResponse.StartUpload.Id,
-		ModuleId: moduleId,
-		Type:     "ICON",
-	})
+	if appTileRes == nil {
+		return errors.New("unable to set app tile")
+	}
 
-	if err != nil {
-		return err
+	if params.Image == nil && params.RemoveIcon {
+		_, err = RemoveDraftModuleIcon(context.Background(), marketplace.gqlClient, RemoveDraftModuleIconV2Input{
+			ModuleId: draftModuleId,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to remove the icon: %w", err)
+		}
 	}
 
-	if finalizeResponse == nil {
-		return errors.New("unable to finalize image upload")
+	if params.Image != nil {
+		if err := marketplace.attachImageToDraftModule(draftModuleId, UploadTypeIcon, params.Image, "", 0); err != nil {
+			return err
+		}
 	}
 
+	if params.ReplacePreviewImages {
+		return marketplace.replaceDraftModulePreviewImages(draftModuleId, params.PreviewImages)
+	}
 	return nil
-
 }
 
+// createAppTileDraftModule creates a complete draft, deleting it again if any step fails
 func (marketplace *MarketplaceClient) createAppTileDraftModule(params appTileCreate) (*string, error) {
 	parentModuleId := ""
 	if params.ParentModuleId != nil {
@@ -123,10 +206,13 @@ func (marketplace *MarketplaceClient) createAppTileDraftModule(params appTileCre
 	}
 
 	res, err := CreateDraftModule(context.Background(), marketplace.gqlClient, CreateDraftModuleInput{
+		Id:             params.ModuleId,
 		Title:          params.Name,
 		Description:    params.Description,
 		ParentModuleId: parentModuleId,
 		Category:       "APP_TILE",
+		Tags:           params.Tags,
+		Prices:         params.Prices,
 	})
 	if err != nil {
 		return nil, err
@@ -136,48 +222,807 @@ func (marketplace *MarketplaceClient) createAppTileDraftModule(params appTileCre
 		return nil, errors.New("unable to create draft module")
 	}
 
-	appTileRes, err := SetAppTile(context.Background(), marketplace.gqlClient, SetPublicAppTileDraftModuleSourceInput{
-		ModuleId: res.CreateDraftModule.Id,
-		SourceInfo: PublicAppTileModuleSourceInfo{
-			Id: params.AppTileId,
-		},
+	if err := marketplace.completeAppTileDraftModule(res.CreateDraftModule.Id, params); err != nil {
+		return nil, marketplace.rollbackDraftModule(res.CreateDraftModule.Id, err)
+	}
+
+	return &res.CreateDraftModule.Id, nil
+}
+
+type appTilePublish struct {
+	Id string
+	// Set when the publish went through the marketplace review process
+	PublishReviewId string
+}
+
+// getConnectAccountStatus returns the status of the seller's connect account, or "" when there is none
+func (marketplace *MarketplaceClient) getConnectAccountStatus() (ConnectAccountStatus, error) {
+	resp, err := GetConnectAccount(context.Background(), marketplace.gqlClient)
+	if err != nil {
+		return "", err
+	}
+	if resp.ConnectAccount == nil {
+		return "", nil
+	}
+	return resp.ConnectAccount.Status, nil
+}
+
+// checkPricesPublishable refuses paid prices unless the connect account can take payments
+func (marketplace *MarketplaceClient) checkPricesPublishable(prices []DraftModulePriceInput) error {
+	paid := false
+	for _, price := range prices {
+		paid = paid || (price.Interval != PaymentIntervalFree && price.Amount > 0)
+	}
+	if !paid {
+		return nil
+	}
+
+	status, err := marketplace.getConnectAccountStatus()
+	if err != nil {
+		return fmt.Errorf("failed to check the connect account: %w", err)
+	}
+	if status == "" {
+		return errors.New("cannot publish paid prices without a connect account, onboard with the link from marketplace_connect_account_link")
+	}
+	if status != ConnectAccountStatusEnabled {
+		return fmt.Errorf("cannot publish paid prices while the connect account status is %s, it must be %s", status, ConnectAccountStatusEnabled)
+	}
+	return nil
+}
+
+func (marketplace *MarketplaceClient) publishAppTileDraftModule(draftModuleId string, params appTileCreate) (*appTilePublish, error) {
+	version := ModuleVersionInput{
+		Version: params.Version,
+	}
+	if params.Review {
+		publishRes, err := PublishModuleV3(context.Background(), marketplace.gqlClient, PublishDraftModuleInputV3{
+			ModuleId: draftModuleId,
+			Version:  version,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if publishRes == nil {
+			return nil, errors.New("unable to publish module")
+		}
+		return &appTilePublish{
+			Id:              publishRes.PublishDraftModuleV3.Id,
+			PublishReviewId: publishRes.PublishDraftModuleV3.PublishReviewId,
+		}, nil
+	}
+
+	publishRes, err := PublishModule(context.Background(), marketplace.gqlClient, PublishDraftModuleInputV2{
+		ModuleId: draftModuleId,
+		Version:  version,
 	})
+	if err != nil {
+		return nil, err
+	}
+	if publishRes == nil {
+		return nil, errors.New("unable to publish module")
+	}
+	return &appTilePublish{Id: publishRes.PublishDraftModuleV2.Id}, nil
+}
 
+// publishNewAppTileModule creates and publishes a draft. A draft that fails to publish is deleted,
+// so nothing is left behind that a retry would duplicate.
+func (marketplace *MarketplaceClient) publishNewAppTileModule(params appTileCreate) (*appTilePublish, error) {
+	draftModuleId, err := marketplace.createAppTileDraftModule(params)
 	if err != nil {
 		return nil, err
 	}
 
-	if appTileRes == nil {
-		return nil, errors.New("unable to set app tile")
+	publish, err := marketplace.publishAppTileDraftModule(*draftModuleId, params)
+	if err != nil {
+		return nil, marketplace.rollbackDraftModule(*draftModuleId, err)
 	}
+	return publish, nil
+}
 
-	err = marketplace.attachImageToDraftModule(res.CreateDraftModule.Id, params.Image)
+// isPendingReview reports whether a publish review is still waiting on a reviewer
+func isPendingReview(status ModuleReviewStatus) bool {
+	return status == ModuleReviewStatusNew || status == ModuleReviewStatusInitialApproval
+}
 
+func (marketplace *MarketplaceClient) getPublishReview(moduleId string, reviewId string) (*PublishReviewFields, error) {
+	resp, err := GetModulePublishReview(context.Background(), marketplace.gqlClient, reviewId, moduleId)
 	if err != nil {
 		return nil, err
 	}
+	return &resp.ModulePublishReview.PublishReviewFields, nil
+}
 
-	return &res.CreateDraftModule.Id, nil
+// cancelPendingPublishReview cancels the publish review if it hasn't been decided yet,
+// so destroyed or republished modules don't leave requests in the reviewer queue
+func (marketplace *MarketplaceClient) cancelPendingPublishReview(moduleId string, reviewId string) error {
+	review, err := marketplace.getPublishReview(moduleId, reviewId)
+	if isNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if !isPendingReview(review.Status) {
+		return nil
+	}
+
+	if _, err := CancelModulePublish(context.Background(), marketplace.gqlClient, moduleId); err != nil {
+		return fmt.Errorf("failed to cancel publish review %s of module %s: %w", reviewId, moduleId, err)
+	}
+	return nil
 }
 
-func (marketplace *MarketplaceClient) publishNewAppTileModule(params appTileCreate) (*string, error) {
-	draftModuleId, err := marketplace.createAppTileDraftModule(params)
+func (marketplace *MarketplaceClient) getInstallPage(org bool, after string, first int, input InstallsInput, sort SortOrder) (*InstallPage, error) {
+	if org {
+		resp, err := GetOrgInstalls(context.Background(), marketplace.gqlClient, after, first, input, sort)
+		if err != nil {
+			return nil, err
+		}
+		return &resp.OrgInstalls.InstallPage, nil
+	}
+	resp, err := GetMyInstalls(context.Background(), marketplace.gqlClient, after, first, input, sort)
 	if err != nil {
 		return nil, err
 	}
-	publishRes, err := PublishModule(context.Background(), marketplace.gqlClient, PublishDraftModuleInputV2{
-		ModuleId: *draftModuleId,
-		Version: ModuleVersionInput{
-			Version: params.Version,
-		},
+	return &resp.MyInstalls.InstallPage, nil
+}
+
+func (marketplace *MarketplaceClient) listInstalls(org bool, input InstallsInput, sort SortOrder) ([]InstallFields, error) {
+	installs := []InstallFields{}
+	after := ""
+	for {
+		page, err := marketplace.getInstallPage(org, after, PAGE_SIZE, input, sort)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, edge := range page.Edges {
+			installs = append(installs, edge.Node.InstallFields)
+		}
+
+		if !page.PageInfo.HasNextPage {
+			return installs, nil
+		}
+		after = page.PageInfo.EndCursor
+	}
+}
+
+func (marketplace *MarketplaceClient) listPublishReviews(moduleId string) ([]PublishReviewFields, error) {
+	reviews := []PublishReviewFields{}
+	after := ""
+	for {
+		resp, err := GetModulePublishReviews(context.Background(), marketplace.gqlClient, moduleId, after, PAGE_SIZE)
+		if err != nil {
+			return nil, err
+		}
+
+		page := resp.ModulePublishReviews
+		for _, edge := range page.Edges {
+			reviews = append(reviews, edge.Node.PublishReviewFields)
+		}
+
+		if !page.PageInfo.HasNextPage {
+			return reviews, nil
+		}
+		after = page.PageInfo.EndCursor
+	}
+}
+
+func (marketplace *MarketplaceClient) listDraftModulesInManualReview(assignedToMe bool) ([]ReviewQueueModule, error) {
+	modules := []ReviewQueueModule{}
+	after := ""
+	for {
+		resp, err := GetDraftModulesInManualReview(context.Background(), marketplace.gqlClient, after, PAGE_SIZE, DraftModulesInManualReviewInput{
+			AssignedToMe: assignedToMe,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		page := resp.DraftModulesInManualReview
+		for _, edge := range page.Edges {
+			modules = append(modules, edge.Node.ReviewQueueModule)
+		}
+
+		if !page.PageInfo.HasNextPage {
+			return modules, nil
+		}
+		after = page.PageInfo.EndCursor
+	}
+}
+
+func (marketplace *MarketplaceClient) listModuleVersions(moduleId string) ([]ModuleVersionFields, error) {
+	versions := []ModuleVersionFields{}
+	after := ""
+	for {
+		resp, err := GetModuleVersions(context.Background(), marketplace.gqlClient, moduleId, after, PAGE_SIZE)
+		if err != nil {
+			return nil, err
+		}
+
+		page := resp.MyModule.VersionsV2
+		for _, edge := range page.Edges {
+			versions = append(versions, edge.Node.ModuleVersionFields)
+		}
+
+		if !page.PageInfo.HasNextPage {
+			return versions, nil
+		}
+		after = page.PageInfo.EndCursor
+	}
+}
+
+// latestModuleVersion returns the highest published semver version of a module, or nil when none
+// parse or the module isn't visible yet, such as while its first publish waits for review
+func (marketplace *MarketplaceClient) latestModuleVersion(moduleId string) (*semver.Version, error) {
+	versions, err := marketplace.listModuleVersions(moduleId)
+	if isNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var latest *semver.Version
+	for _, version := range versions {
+		parsed, err := semver.NewVersion(version.Version)
+		if err != nil {
+			continue
+		}
+		if latest == nil || latest.LessThan(*parsed) {
+			latest = parsed
+		}
+	}
+	return latest, nil
+}
+
+// installedVersions returns the versions of a module that the caller or its organization has installed
+func (marketplace *MarketplaceClient) installedVersions(moduleId string) (map[string]bool, error) {
+	installed := map[string]bool{}
+	for _, org := range []bool{false, true} {
+		installs, err := marketplace.listInstalls(org, InstallsInput{ModuleId: moduleId}, SortOrderDesc)
+		if err != nil {
+			return nil, err
+		}
+		for _, install := range installs {
+			if module, ok := install.Module.(*InstallFieldsModuleMarketplaceModule); ok {
+				installed[module.Version] = true
+			}
+		}
+	}
+	return installed, nil
+}
+
+// versionsToPrune picks the versions published before the newest retain versions, skipping installed ones
+func versionsToPrune(versions []ModuleVersionFields, retain int, installed map[string]bool) []string {
+	sorted := make([]ModuleVersionFields, len(versions))
+	copy(sorted, versions)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Created > sorted[j].Created
 	})
+
+	prune := []string{}
+	for i, version := range sorted {
+		if i < retain || installed[version.Version] {
+			continue
+		}
+		prune = append(prune, version.Version)
+	}
+	return prune
+}
+
+// pruneModuleVersions deletes the versions of a module older than the newest retain versions.
+// Versions that are still installed are never deleted.
+func (marketplace *MarketplaceClient) pruneModuleVersions(moduleId string, retain int) ([]string, error) {
+	versions, err := marketplace.listModuleVersions(moduleId)
 	if err != nil {
 		return nil, err
 	}
-	if publishRes == nil {
-		return nil, errors.New("unable to publish module")
+	if len(versions) <= retain {
+		return []string{}, nil
+	}
+
+	installed, err := marketplace.installedVersions(moduleId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check which versions of module %s are installed, so none were pruned: %w", moduleId, err)
+	}
+
+	deleted := []string{}
+	for _, version := range versionsToPrune(versions, retain, installed) {
+		if _, err := DeleteModule(context.Background(), marketplace.gqlClient, DeleteModuleInput{
+			ModuleId: moduleId,
+			Version:  version,
+		}); err != nil {
+			return deleted, fmt.Errorf("failed to delete version %s of module %s: %w", version, moduleId, err)
+		}
+		deleted = append(deleted, version)
+	}
+	return deleted, nil
+}
+
+func (marketplace *MarketplaceClient) listModuleReviews(moduleId string) ([]ReviewFields, error) {
+	reviews := []ReviewFields{}
+	after := ""
+	for {
+		resp, err := GetModuleReviews(context.Background(), marketplace.gqlClient, moduleId, after, PAGE_SIZE)
+		if err != nil {
+			return nil, err
+		}
+
+		page := resp.MyModule.Reviews
+		for _, edge := range page.Edges {
+			reviews = append(reviews, edge.Node.ReviewFields)
+		}
+
+		if !page.PageInfo.HasNextPage {
+			return reviews, nil
+		}
+		after = page.PageInfo.EndCursor
+	}
+}
+
+// listReviewReplies pages through every reply to a review. Replies can only be reached through the
+// page of reviews that holds the review, so that page is requested again for each page of replies.
+func (marketplace *MarketplaceClient) listReviewReplies(moduleId string, ratingId string) ([]reviewReply, error) {
+	replies := []reviewReply{}
+	reviewsAfter := ""
+	repliesAfter := ""
+	for {
+		resp, err := GetReviewReplies(context.Background(), marketplace.gqlClient, moduleId, reviewsAfter, PAGE_SIZE, repliesAfter, PAGE_SIZE)
+		if err != nil {
+			return nil, err
+		}
+
+		page := resp.MyModule.Reviews
+		var review *GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReview
+		for i, edge := range page.Edges {
+			if edge.Node.Id == ratingId {
+				review = &page.Edges[i].Node
+				break
+			}
+		}
+		if review == nil {
+			if repliesAfter != "" {
+				return nil, fmt.Errorf("review %s moved to another page while listing its replies", ratingId)
+			}
+			if !page.PageInfo.HasNextPage {
+				return replies, nil
+			}
+			reviewsAfter = page.PageInfo.EndCursor
+			continue
+		}
+		if review.Comment == nil {
+			return replies, nil
+		}
+
+		connection := review.Comment.GetReplies()
+		for _, edge := range connection.Edges {
+			if edge.Node != nil {
+				replies = append(replies, newReviewReply(edge.Node))
+			}
+		}
+		if !connection.PageInfo.HasNextPage {
+			return replies, nil
+		}
+		repliesAfter = connection.PageInfo.EndCursor
+	}
+}
+
+type moduleTag struct {
+	Value string
+	Count int
+}
+
+func (marketplace *MarketplaceClient) listModuleTags(org bool, category ModuleCategory, value string) ([]moduleTag, error) {
+	tags := []moduleTag{}
+	after := ""
+	for {
+		var page ModuleTagPage
+		if org {
+			resp, err := GetOrgModuleTags(context.Background(), marketplace.gqlClient, after, PAGE_SIZE, OrgModuleTagsInput{
+				Category: category,
+				Value:    value,
+			})
+			if err != nil {
+				return nil, err
+			}
+			page = resp.OrgModuleTags.ModuleTagPage
+		} else {
+			resp, err := GetModuleTags(context.Background(), marketplace.gqlClient, after, PAGE_SIZE, ModuleTagsInput{
+				Category: category,
+				Value:    value,
+			})
+			if err != nil {
+				return nil, err
+			}
+			page = resp.ModuleTags.ModuleTagPage
+		}
+
+		for _, edge := range page.Edges {
+			tags = append(tags, moduleTag{Value: edge.Node.Value, Count: edge.Node.Count})
+		}
+
+		if !page.PageInfo.HasNextPage {
+			return tags, nil
+		}
+		after = page.PageInfo.EndCursor
+	}
+}
+
+// tagCatalog returns every tag already used by public or organization modules
+func (marketplace *MarketplaceClient) tagCatalog() (map[string]bool, error) {
+	catalog := map[string]bool{}
+	for _, org := range []bool{false, true} {
+		tags, err := marketplace.listModuleTags(org, "", "")
+		if err != nil {
+			return nil, err
+		}
+		for _, tag := range tags {
+			catalog[tag.Value] = true
+		}
+	}
+	return catalog, nil
+}
+
+func (marketplace *MarketplaceClient) listPurchases(status PurchaseStatus, purchaseType PurchaseType) ([]PurchaseFields, error) {
+	purchases := []PurchaseFields{}
+	after := ""
+	for {
+		resp, err := GetMyPurchases(context.Background(), marketplace.gqlClient, after, PAGE_SIZE, status, purchaseType)
+		if err != nil {
+			return nil, err
+		}
+
+		page := resp.MyPurchases
+		for _, edge := range page.Edges {
+			purchases = append(purchases, edge.Node.PurchaseFields)
+		}
+
+		if !page.PageInfo.HasNextPage {
+			return purchases, nil
+		}
+		after = page.PageInfo.EndCursor
+	}
+}
+
+// getUserPurchase returns the caller's purchase of a module, or nil if it hasn't been purchased
+func (marketplace *MarketplaceClient) getUserPurchase(moduleId string) (*PurchaseFields, error) {
+	resp, err := GetUserPurchasedModule(context.Background(), marketplace.gqlClient, moduleId)
+	if err != nil {
+		return nil, err
+	}
+	if !resp.UserPurchasedModule.Purchased || resp.UserPurchasedModule.Purchase == nil {
+		return nil, nil
+	}
+	return &resp.UserPurchasedModule.Purchase.PurchaseFields, nil
+}
+
+// checkModuleUnused returns an error describing the active installs and purchases of a module that
+// the caller can see, which are only its organization's installs and its own purchase
+func (marketplace *MarketplaceClient) checkModuleUnused(moduleId string) error {
+	installs, err := marketplace.listInstalls(true, InstallsInput{ModuleId: moduleId}, SortOrderDesc)
+	if err != nil {
+		return fmt.Errorf("failed to check installs of module %s: %w", moduleId, err)
+	}
+	active := 0
+	for _, install := range installs {
+		if _, ok := install.Module.(*InstallFieldsModuleMarketplaceModule); ok {
+			active += 1
+		}
+	}
+	if active > 0 {
+		return fmt.Errorf("module %s still has %d active installs, set force_delete = true to delete it anyway", moduleId, active)
+	}
+
+	purchase, err := marketplace.getUserPurchase(moduleId)
+	if err != nil {
+		return fmt.Errorf("failed to check purchases of module %s: %w", moduleId, err)
+	}
+	if purchase != nil && purchase.Status == PurchaseStatusActive {
+		return fmt.Errorf("module %s still has an active %s purchase %s, set force_delete = true to delete it anyway", moduleId, purchase.Type, purchase.PurchaseId)
+	}
+	return nil
+}
+
+type moduleInstall struct {
+	ModuleId                string
+	Version                 string
+	Project                 string
+	SurveyVersion           string
+	EnrollmentScheduledTime string
+	Organization            bool
+	// When set, the install fails unless the module has this category
+	ExpectedCategory ModuleCategory
+}
+
+type moduleInstallResult struct {
+	Category   ModuleCategory
+	AppTileId  string
+	ResourceId string
+}
+
+// Categories whose install mutation requires the project to install into
+var projectInstallCategories = map[ModuleCategory]bool{
+	ModuleCategoryConsent:             true,
+	ModuleCategoryDomainOntology:      true,
+	ModuleCategoryInsightsLayout:      true,
+	ModuleCategoryPatientViewerLayout: true,
+	ModuleCategoryProcessOntology:     true,
+	ModuleCategoryProgramTemplate:     true,
+	ModuleCategoryReportExtractor:     true,
+	ModuleCategorySearchLayout:        true,
+	ModuleCategorySurvey:              true,
+}
+
+func (marketplace *MarketplaceClient) getModuleCategory(moduleId string, version string, org bool) (ModuleCategory, error) {
+	if org {
+		resp, err := GetOrgModuleCategory(context.Background(), marketplace.gqlClient, moduleId, version)
+		if err != nil {
+			return "", err
+		}
+		return resp.OrgModule.Category, nil
+	}
+	resp, err := GetModuleCategory(context.Background(), marketplace.gqlClient, moduleId, version)
+	if err != nil {
+		return "", err
+	}
+	return resp.Module.Category, nil
+}
+
+func (marketplace *MarketplaceClient) installModule(params moduleInstall) (*moduleInstallResult, error) {
+	category, err := marketplace.getModuleCategory(params.ModuleId, params.Version, params.Organization)
+	if err != nil {
+		return nil, err
+	}
+
+	if params.ExpectedCategory != "" && category != params.ExpectedCategory {
+		return nil, fmt.Errorf("module %s is a %s module, not a %s module", params.ModuleId, category, params.ExpectedCategory)
+	}
+
+	if projectInstallCategories[category] && params.Project == "" {
+		return nil, fmt.Errorf("modules of category %s must be installed into a project", category)
+	}
+
+	ctx := context.Background()
+	result := moduleInstallResult{Category: category}
+	switch category {
+	case ModuleCategoryAppTile:
+		var res *InstallPublicAppTileModuleResponse
+		res, err = InstallPublicAppTileModule(ctx, marketplace.gqlClient, InstallPublicAppTileModuleInput{
+			ModuleId: params.ModuleId,
+			Version:  params.Version,
+		})
+		if err == nil {
+			result.AppTileId = res.InstallPublicAppTileModule.AppTileId
+		}
+	case ModuleCategoryConsent:
+		_, err = InstallConsentModule(ctx, marketplace.gqlClient, InstallConsentModuleInput{
+			ModuleId: params.ModuleId,
+			Project:  params.Project,
+			Version:  params.Version,
+		})
+	case ModuleCategoryDomainOntology:
+		var res *InstallDomainOntologyModuleResponse
+		res, err = InstallDomainOntologyModule(ctx, marketplace.gqlClient, InstallDomainOntologyModuleInput{
+			ModuleId: params.ModuleId,
+			Project:  params.Project,
+			Version:  params.Version,
+		})
+		if err == nil {
+			result.ResourceId = res.InstallDomainOntologyModule.Id
+		}
+	case ModuleCategoryInsightsLayout:
+		_, err = InstallInsightsLayoutModule(ctx, marketplace.gqlClient, InstallInsightsLayoutModuleInput{
+			ModuleId: params.ModuleId,
+			Project:  params.Project,
+			Version:  params.Version,
+		})
+	case ModuleCategoryNotebook:
+		_, err = InstallNotebookModule(ctx, marketplace.gqlClient, InstallNotebookModuleInput{
+			ModuleId: params.ModuleId,
+			Version:  params.Version,
+		})
+	case ModuleCategoryPatientViewerLayout:
+		_, err = InstallPatientLayoutModule(ctx, marketplace.gqlClient, InstallPatientLayoutModuleInput{
+			ModuleId: params.ModuleId,
+			Project:  params.Project,
+			Version:  params.Version,
+		})
+	case ModuleCategoryProcessOntology:
+		var res *InstallProcessOntologyModuleResponse
+		res, err = InstallProcessOntologyModule(ctx, marketplace.gqlClient, InstallProcessOntologyModuleInput{
+			ModuleId: params.ModuleId,
+			Project:  params.Project,
+			Version:  params.Version,
+		})
+		if err == nil {
+			result.ResourceId = res.InstallProcessOntologyModule.Id
+		}
+	case ModuleCategoryProgramEnrollment:
+		_, err = InstallProgramEnrollmentModule(ctx, marketplace.gqlClient, InstallProgramEnrollmentModuleInput{
+			EnrollmentScheduledTime: params.EnrollmentScheduledTime,
+			ModuleId:                params.ModuleId,
+			Version:                 params.Version,
+		})
+	case ModuleCategoryProgramTemplate:
+		_, err = InstallProgramTemplateModule(ctx, marketplace.gqlClient, InstallProgramTemplateModuleInput{
+			ModuleId: params.ModuleId,
+			Project:  params.Project,
+			Version:  params.Version,
+		})
+	case ModuleCategoryReportExtractor:
+		_, err = InstallReportExtractorModule(ctx, marketplace.gqlClient, InstallReportExtractorModuleInput{
+			ModuleId: params.ModuleId,
+			Project:  params.Project,
+			Version:  params.Version,
+		})
+	case ModuleCategorySearchLayout:
+		_, err = InstallSearchLayoutModule(ctx, marketplace.gqlClient, InstallSearchLayoutModuleInput{
+			ModuleId: params.ModuleId,
+			Project:  params.Project,
+			Version:  params.Version,
+		})
+	case ModuleCategorySurvey:
+		_, err = InstallSurveyModule(ctx, marketplace.gqlClient, InstallSurveyModuleInput{
+			ModuleId:      params.ModuleId,
+			Project:       params.Project,
+			SurveyVersion: params.SurveyVersion,
+			Version:       params.Version,
+		})
+	case ModuleCategoryWorkflow:
+		_, err = InstallWorkflowModule(ctx, marketplace.gqlClient, InstallWorkflowModuleInput{
+			ModuleId: params.ModuleId,
+			Version:  params.Version,
+		})
+	case ModuleCategoryWellnessOffering:
+		return nil, errors.New("wellness offering modules require configuration, use marketplace_wellness_offering_install instead")
+	default:
+		return nil, fmt.Errorf("installing modules of category %s is not supported", category)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+type wellnessOfferingInstall struct {
+	ModuleId         string
+	Version          string
+	Configuration    string
+	Enabled          bool
+	EngagementTarget int
+	SubsidyAmount    int
+	SubsidyPeriod    Period
+}
+
+func (marketplace *MarketplaceClient) getWellnessOfferingConfigurationSchema(moduleId string, version string) (string, error) {
+	resp, err := GetWellnessOfferingSource(context.Background(), marketplace.gqlClient, moduleId, version)
+	if err != nil {
+		return "", err
+	}
+	source, ok := resp.Module.Source.(*GetWellnessOfferingSourceModuleMarketplaceModuleSourceWellnessOffering)
+	if !ok {
+		return "", fmt.Errorf("module %s is a %s module, not a wellness offering", moduleId, resp.Module.Category)
+	}
+	return source.ConfigurationSchema, nil
+}
+
+func (marketplace *MarketplaceClient) installWellnessOffering(params wellnessOfferingInstall) (string, error) {
+	res, err := InstallWellnessOfferingModule(context.Background(), marketplace.gqlClient, InstallWellnessOfferingModuleInput{
+		Configuration:    params.Configuration,
+		Enabled:          params.Enabled,
+		EngagementTarget: params.EngagementTarget,
+		ModuleId:         params.ModuleId,
+		SubsidyAmount:    params.SubsidyAmount,
+		SubsidyPeriod:    params.SubsidyPeriod,
+		Version:          params.Version,
+	})
+	if err != nil {
+		return "", err
+	}
+	return res.InstallWellnessOfferingModule.Id, nil
+}
+
+// recentInstalls returns the newest installs of the given module version, newest first
+func (marketplace *MarketplaceClient) recentInstalls(moduleId string, version string, org bool) ([]InstallFields, error) {
+	page, err := marketplace.getInstallPage(org, "", PAGE_SIZE, InstallsInput{ModuleId: moduleId, ModuleVersion: version}, SortOrderDesc)
+	if err != nil {
+		return nil, err
+	}
+
+	installs := make([]InstallFields, 0, len(page.Edges))
+	for _, edge := range page.Edges {
+		installs = append(installs, edge.Node.InstallFields)
+	}
+	return installs, nil
+}
+
+// newInstall picks the install listed in after that wasn't listed in before, or nil while there is
+// none yet. Installs don't record their project and the install mutations don't return an install
+// id, so more than one new install can't be told apart and is an error.
+func newInstall(before []InstallFields, after []InstallFields) (*InstallFields, error) {
+	known := map[string]bool{}
+	var newest int64
+	for _, install := range before {
+		known[install.Id] = true
+		if install.InstalledOn > newest {
+			newest = install.InstalledOn
+		}
+	}
+
+	var found *InstallFields
+	for i, install := range after {
+		if known[install.Id] || install.InstalledOn < newest {
+			continue
+		}
+		if found != nil {
+			return nil, fmt.Errorf("installs %s and %s both appeared while installing, so it isn't clear which one was created, import the right install instead", found.Id, install.Id)
+		}
+		found = &after[i]
+	}
+	return found, nil
+}
+
+func (marketplace *MarketplaceClient) getInstall(installId string, installedOn int64, org bool) (*InstallFields, error) {
+	if org {
+		resp, err := GetOrgInstall(context.Background(), marketplace.gqlClient, installId, installedOn)
+		if err != nil {
+			return nil, err
+		}
+		return &resp.OrgInstall.InstallFields, nil
+	}
+	resp, err := GetMyInstall(context.Background(), marketplace.gqlClient, installId, installedOn)
+	if err != nil {
+		return nil, err
+	}
+	return &resp.MyInstall.InstallFields, nil
+}
+
+// notFoundCodes are the GraphQL error extension codes that mean the entity doesn't exist
+var notFoundCodes = map[string]bool{
+	"NOT_FOUND":          true,
+	"MODULE_NOT_FOUND":   true,
+	"ENTITY_NOT_FOUND":   true,
+	"RESOURCE_NOT_FOUND": true,
+}
+
+func isNotFoundMessage(message string) bool {
+	message = strings.ToLower(message)
+	return strings.Contains(message, "not found") || strings.Contains(message, "does not exist")
+}
+
+// isNotFound reports whether a GraphQL error means the requested entity does not exist. An error
+// with a code extension counts only when the code is a not found code. Without a code the message
+// is checked, but only for errors with a path, which the resolvers return, so that validation
+// errors about a missing field or argument aren't mistaken for a missing entity. Errors that aren't
+// GraphQL errors, such as HTTP failures, never count.
+func isNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	var gqlErrors gqlerror.List
+	if !errors.As(err, &gqlErrors) {
+		var gqlErr *gqlerror.Error
+		if !errors.As(err, &gqlErr) {
+			return false
+		}
+		gqlErrors = gqlerror.List{gqlErr}
+	}
+
+	for _, gqlErr := range gqlErrors {
+		if code, ok := gqlErr.Extensions["code"].(string); ok {
+			if notFoundCodes[code] {
+				return true
+			}
+			continue
+		}
+		if len(gqlErr.Path) > 0 && isNotFoundMessage(gqlErr.Message) {
+			return true
+		}
 	}
-	return &publishRes.PublishDraftModuleV2.Id, nil
+	return false
 }
 
 func BuildAppStoreClient() (*MarketplaceClient, error) {
@@ -0,0 +1,221 @@
+package marketplace
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// fakeGraphQLHandler answers one operation with the response data, or with a GraphQL error
+type fakeGraphQLHandler func(operation string, variables map[string]interface{}) (interface{}, *gqlerror.Error)
+
+// newFakeGraphQLClient returns a client whose GraphQL requests are answered by handle
+func newFakeGraphQLClient(t *testing.T, handle fakeGraphQLHandler) *MarketplaceClient {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			OperationName string                 `json:"operationName"`
+			Variables     map[string]interface{} `json:"variables"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Error(err)
+			return
+		}
+
+		data, gqlErr := handle(req.OperationName, req.Variables)
+		resp := map[string]interface{}{"data": data}
+		if gqlErr != nil {
+			resp["errors"] = gqlerror.List{gqlErr}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp)
+	}))
+	t.Cleanup(server.Close)
+	return &MarketplaceClient{gqlClient: graphql.NewClient(server.URL, server.Client())}
+}
+
+func TestIsNotFound(t *testing.T) {
+	cases := []struct {
+		name     string
+		err      error
+		notFound bool
+	}{
+		{"nil", nil, false},
+		{"code", gqlerror.List{{Message: "Module abc", Extensions: map[string]interface{}{"code": "NOT_FOUND"}}}, true},
+		{"other code", gqlerror.List{{Message: "Module not found in cache", Extensions: map[string]interface{}{"code": "FORBIDDEN"}}}, false},
+		{"message", gqlerror.List{{Message: "Module abc not found", Path: ast.Path{ast.PathName("myModule")}}}, true},
+		{"wrapped", fmt.Errorf("reading: %w", gqlerror.List{{Message: "Module abc does not exist", Path: ast.Path{ast.PathName("myModule")}}}), true},
+		{"single", &gqlerror.Error{Message: "Install not found", Path: ast.Path{ast.PathName("myInstalls")}}, true},
+		{"validation", gqlerror.List{{Message: `Argument "moduleId" not found on field "myModule"`}}, false},
+		{"other", gqlerror.List{{Message: "Unauthorized"}}, false},
+		{"plain", errors.New("returned error 500 Internal Server Error"), false},
+		{"plain not found", errors.New("returned error 404 Not Found"), false},
+	}
+
+	for _, c := range cases {
+		if isNotFound(c.err) != c.notFound {
+			t.Errorf("%s: expected isNotFound to be %t", c.name, c.notFound)
+		}
+	}
+}
+
+func TestNewInstall(t *testing.T) {
+	old := InstallFields{Id: "old", InstalledOn: 100}
+	ours := InstallFields{Id: "ours", InstalledOn: 200}
+	other := InstallFields{Id: "other", InstalledOn: 200}
+
+	if install, err := newInstall([]InstallFields{old}, []InstallFields{old}); err != nil || install != nil {
+		t.Errorf("expected no new install yet, got %v, %v", install, err)
+	}
+	if install, err := newInstall([]InstallFields{old}, []InstallFields{ours, old}); err != nil || install == nil || install.Id != "ours" {
+		t.Errorf("expected the new install, got %v, %v", install, err)
+	}
+	if install, err := newInstall(nil, []InstallFields{ours}); err != nil || install == nil || install.Id != "ours" {
+		t.Errorf("expected the first install, got %v, %v", install, err)
+	}
+	if _, err := newInstall([]InstallFields{old}, []InstallFields{ours, other, old}); err == nil {
+		t.Error("expected two new installs to be ambiguous")
+	}
+	// An install older than the ones listed before pages in late and isn't the one just made
+	if install, err := newInstall([]InstallFields{ours}, []InstallFields{ours, old}); err != nil || install != nil {
+		t.Errorf("expected an older install to be ignored, got %v, %v", install, err)
+	}
+}
+
+func TestVersionsToPrune(t *testing.T) {
+	versions := []ModuleVersionFields{
+		{Version: "1.0.0", Created: 100},
+		{Version: "1.2.0", Created: 300},
+		{Version: "not-semver", Created: 200},
+		{Version: "1.1.0", Created: 250},
+		{Version: "0.9.0", Created: 50},
+	}
+	cases := []struct {
+		name      string
+		retain    int
+		installed map[string]bool
+		expected  []string
+	}{
+		{"retain all", 5, nil, []string{}},
+		{"by publish time", 2, nil, []string{"not-semver", "1.0.0", "0.9.0"}},
+		{"installed kept", 2, map[string]bool{"1.0.0": true}, []string{"not-semver", "0.9.0"}},
+		{"keep newest only", 1, nil, []string{"1.1.0", "not-semver", "1.0.0", "0.9.0"}},
+	}
+
+	for _, c := range cases {
+		pruned := versionsToPrune(versions, c.retain, c.installed)
+		if strings.Join(pruned, ",") != strings.Join(c.expected, ",") {
+			t.Errorf("%s: got %v, expected %v", c.name, pruned, c.expected)
+		}
+	}
+}
+
+func TestPublishNewAppTileModuleRollback(t *testing.T) {
+	published := "published-module"
+	cases := []struct {
+		name   string
+		parent *string
+		failOn string
+	}{
+		{"create, publish fails", nil, "PublishModule"},
+		{"create, source fails", nil, "SetAppTile"},
+		{"update, publish fails", &published, "PublishModule"},
+		{"update, source fails", &published, "SetAppTile"},
+	}
+
+	for _, c := range cases {
+		deleted := []string{}
+		client := newFakeGraphQLClient(t, func(operation string, variables map[string]interface{}) (interface{}, *gqlerror.Error) {
+			if operation == c.failOn {
+				return nil, &gqlerror.Error{Message: "Internal server error"}
+			}
+			switch operation {
+			case "CreateDraftModule":
+				input := variables["input"].(map[string]interface{})
+				if parent, _ := input["parentModuleId"].(string); c.parent != nil && parent != *c.parent {
+					t.Errorf("%s: expected the draft to have parent %s, got %q", c.name, *c.parent, parent)
+				}
+				return map[string]interface{}{"createDraftModule": map[string]interface{}{"id": "draft-module"}}, nil
+			case "SetAppTile":
+				return map[string]interface{}{"setPublicAppTileDraftModuleSource": map[string]interface{}{"moduleId": "draft-module"}}, nil
+			case "DeleteDraftModule":
+				deleted = append(deleted, variables["moduleId"].(string))
+				return map[string]interface{}{"deleteDraftModule": map[string]interface{}{"id": variables["moduleId"]}}, nil
+			}
+			t.Errorf("%s: unexpected operation %s", c.name, operation)
+			return nil, &gqlerror.Error{Message: "unexpected operation"}
+		})
+
+		_, err := client.publishNewAppTileModule(appTileCreate{
+			Name:           "name",
+			AppTileId:      "app-tile",
+			Version:        "1.0.0",
+			ParentModuleId: c.parent,
+		})
+		if err == nil || !strings.Contains(err.Error(), "Internal server error") {
+			t.Errorf("%s: expected the failure to be returned, got %v", c.name, err)
+		}
+		if len(deleted) != 1 || deleted[0] != "draft-module" {
+			t.Errorf("%s: expected only the draft to be deleted, deleted %v", c.name, deleted)
+		}
+	}
+}
+
+func TestListReviewReplies(t *testing.T) {
+	reply := func(id string) map[string]interface{} {
+		return map[string]interface{}{"node": map[string]interface{}{"__typename": "ActivePost", "id": id, "message": "reply " + id, "authorId": "owner"}}
+	}
+	review := func(id string, replies []interface{}, next string) map[string]interface{} {
+		return map[string]interface{}{"node": map[string]interface{}{"id": id, "comment": map[string]interface{}{
+			"__typename": "ActivePost",
+			"replies": map[string]interface{}{
+				"edges":    replies,
+				"pageInfo": map[string]interface{}{"hasNextPage": next != "", "endCursor": next},
+			},
+		}}}
+	}
+
+	client := newFakeGraphQLClient(t, func(operation string, variables map[string]interface{}) (interface{}, *gqlerror.Error) {
+		reviewsAfter, _ := variables["after"].(string)
+		repliesAfter, _ := variables["repliesAfter"].(string)
+		var reviews map[string]interface{}
+		switch {
+		case reviewsAfter == "":
+			reviews = map[string]interface{}{
+				"edges":    []interface{}{review("other", []interface{}{reply("x")}, "")},
+				"pageInfo": map[string]interface{}{"hasNextPage": true, "endCursor": "reviews-2"},
+			}
+		case repliesAfter == "":
+			reviews = map[string]interface{}{
+				"edges":    []interface{}{review("rating", []interface{}{reply("c"), map[string]interface{}{"node": map[string]interface{}{"__typename": "DeletedPost", "id": "b"}}}, "replies-2")},
+				"pageInfo": map[string]interface{}{"hasNextPage": false},
+			}
+		default:
+			reviews = map[string]interface{}{
+				"edges":    []interface{}{review("rating", []interface{}{reply("a")}, "")},
+				"pageInfo": map[string]interface{}{"hasNextPage": false},
+			}
+		}
+		return map[string]interface{}{"myModule": map[string]interface{}{"reviews": reviews}}, nil
+	})
+
+	replies, err := client.listReviewReplies("module", "rating")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []reviewReply{
+		{Id: "c", Message: "reply c", AuthorId: "owner"},
+		{Id: "b", Deleted: true},
+		{Id: "a", Message: "reply a", AuthorId: "owner"},
+	}
+	if fmt.Sprint(replies) != fmt.Sprint(expected) {
+		t.Errorf("expected %v, got %v", expected, replies)
+	}
+}
@@ -0,0 +1,124 @@
+package marketplace
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func readConnectAccount(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*MarketplaceClient)
+	status, err := client.getConnectAccountStatus()
+	if err != nil {
+		return fmt.Errorf("failed to read the connect account: %w", err)
+	}
+
+	d.Set("exists", status != "")
+	d.Set("status", string(status))
+	d.Set("enabled", status == ConnectAccountStatusEnabled)
+	d.SetId("connect_account")
+	return nil
+}
+
+func readConnectAccountLink(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*MarketplaceClient).gqlClient
+	resp, err := GetConnectAccountLink(context.Background(), client, GetConnectAccountLinkInput{
+		Domain:  d.Get("domain").(string),
+		Expired: d.Get("expired").(string),
+		Success: d.Get("success").(string),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get a connect account link: %w", err)
+	}
+
+	// Every read generates a new short lived link, so the link itself can't be the id
+	d.Set("url", resp.GetConnectAccountLink.Url)
+	d.SetId("connect_account_link")
+	return nil
+}
+
+func readBillingPortalLink(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*MarketplaceClient).gqlClient
+	resp, err := GetBillingPortalLink(context.Background(), client, GetBillingPortalLinkInput{
+		Domain:  d.Get("domain").(string),
+		Success: d.Get("success").(string),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get a billing portal link: %w", err)
+	}
+
+	d.Set("url", resp.GetBillingPortalLink.Url)
+	d.SetId("billing_portal_link")
+	return nil
+}
+
+func connectAccountDataSource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"exists": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ENABLED, ERROR, PAYOUTS_DISABLED, PENDING or WARNING, empty when there is no account",
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+		Read: readConnectAccount,
+	}
+}
+
+func connectAccountLinkDataSource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"success": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Path to return to once onboarding is done",
+			},
+			"expired": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Path to redirect to when the link has expired",
+			},
+			"domain": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Short lived onboarding link, a new one is generated on every read",
+			},
+		},
+		Read: readConnectAccountLink,
+	}
+}
+
+func billingPortalLinkDataSource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"success": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Path to return to when leaving the billing portal",
+			},
+			"domain": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Short lived billing portal link, a new one is generated on every read",
+			},
+		},
+		Read: readBillingPortalLink,
+	}
+}
@@ -0,0 +1,141 @@
+package marketplace
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func flattenInstall(install InstallFields) map[string]interface{} {
+	result := map[string]interface{}{
+		"id":              install.Id,
+		"installed_on":    int(install.InstalledOn),
+		"module_id":       "",
+		"module_title":    "",
+		"module_version":  "",
+		"module_category": "",
+		"module_deleted":  false,
+		"incorrect_scope": false,
+		"message":         "",
+	}
+
+	switch module := install.Module.(type) {
+	case *InstallFieldsModuleMarketplaceModule:
+		result["module_id"] = module.Id
+		result["module_title"] = module.Title
+		result["module_version"] = module.Version
+		result["module_category"] = string(module.Category)
+	case *InstallFieldsModuleModuleDeletedMessage:
+		result["module_id"] = module.ModuleId
+		result["module_deleted"] = true
+		result["message"] = module.Message
+	case *InstallFieldsModuleIncorrectScopeMessage:
+		result["incorrect_scope"] = true
+		result["message"] = module.Message
+	}
+
+	return result
+}
+
+func readInstalls(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*MarketplaceClient)
+	moduleId := d.Get("module_id").(string)
+	moduleVersion := d.Get("module_version").(string)
+	org := d.Get("organization").(bool)
+	sort := d.Get("sort").(string)
+
+	installs, err := client.listInstalls(org, InstallsInput{
+		ModuleId:      moduleId,
+		ModuleVersion: moduleVersion,
+	}, SortOrder(sort))
+	if err != nil {
+		return fmt.Errorf("failed to list installs: %w", err)
+	}
+
+	flattened := make([]map[string]interface{}, 0, len(installs))
+	for _, install := range installs {
+		flattened = append(flattened, flattenInstall(install))
+	}
+
+	if err := d.Set("installs", flattened); err != nil {
+		return err
+	}
+	d.SetId(fmt.Sprintf("%s:%s:%t:%s", moduleId, moduleVersion, org, sort))
+	return nil
+}
+
+func installsDataSource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"module_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"module_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"organization": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "List installs for the whole organization (orgInstalls) instead of the caller (myInstalls)",
+			},
+			"sort": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  string(SortOrderDesc),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(SortOrderAsc),
+					string(SortOrderDesc),
+				}, false),
+			},
+			"installs": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"installed_on": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"module_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"module_title": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"module_version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"module_category": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"module_deleted": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"incorrect_scope": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"message": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+		Read: readInstalls,
+	}
+}
@@ -0,0 +1,91 @@
+package marketplace
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func publishReviewsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"status": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"notes": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"reviewer": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"version": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"changelog": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"created": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func flattenPublishReviews(reviews []PublishReviewFields) []map[string]interface{} {
+	flattened := make([]map[string]interface{}, 0, len(reviews))
+	for _, review := range reviews {
+		flattened = append(flattened, map[string]interface{}{
+			"id":        review.Id,
+			"status":    string(review.Status),
+			"notes":     review.Notes,
+			"reviewer":  review.CreatedBy,
+			"version":   review.ModuleVersion,
+			"changelog": review.ModuleVersionChangelog,
+			"created":   int(review.Created),
+		})
+	}
+	return flattened
+}
+
+func readModulePublishReviews(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*MarketplaceClient)
+	moduleId := d.Get("module_id").(string)
+
+	reviews, err := client.listPublishReviews(moduleId)
+	if err != nil {
+		return fmt.Errorf("failed to list publish reviews of module %s: %w", moduleId, err)
+	}
+
+	if err := d.Set("reviews", flattenPublishReviews(reviews)); err != nil {
+		return err
+	}
+	d.SetId(moduleId)
+	return nil
+}
+
+func modulePublishReviewsDataSource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"module_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"reviews": publishReviewsSchema(),
+		},
+		Read: readModulePublishReviews,
+	}
+}
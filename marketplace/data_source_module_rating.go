@@ -0,0 +1,88 @@
+package marketplace
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ratingHistogram counts reviews by score, always including every score from 1 to 5
+func ratingHistogram(reviews []ReviewFields, version string) map[string]interface{} {
+	histogram := map[string]interface{}{}
+	for score := 1; score <= 5; score++ {
+		histogram[strconv.Itoa(score)] = 0
+	}
+	for _, review := range reviews {
+		if version != "" && review.EntityVersion != version {
+			continue
+		}
+		key := strconv.Itoa(review.Rating)
+		count, _ := histogram[key].(int)
+		histogram[key] = count + 1
+	}
+	return histogram
+}
+
+func readModuleRating(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*MarketplaceClient)
+	moduleId := d.Get("module_id").(string)
+	version := d.Get("version").(string)
+
+	resp, err := GetModuleRating(context.Background(), client.gqlClient, moduleId, version)
+	if err != nil {
+		return fmt.Errorf("failed to read the rating of module %s: %w", moduleId, err)
+	}
+
+	reviews, err := client.listModuleReviews(moduleId)
+	if err != nil {
+		return fmt.Errorf("failed to list reviews of module %s: %w", moduleId, err)
+	}
+
+	rating := resp.MyModule.Rating
+	average := 0.0
+	if rating.Average != nil {
+		average = *rating.Average
+	}
+	d.Set("average", average)
+	d.Set("rating_count", rating.Count)
+	if err := d.Set("histogram", ratingHistogram(reviews, version)); err != nil {
+		return err
+	}
+	d.SetId(fmt.Sprintf("%s:%s", moduleId, version))
+	return nil
+}
+
+func moduleRatingDataSource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"module_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"average": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "Average rating, 0 when the module has no ratings",
+			},
+			"rating_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"histogram": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "Number of reviews for each score from 1 to 5",
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+		},
+		Read: readModuleRating,
+	}
+}
@@ -0,0 +1,181 @@
+package marketplace
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type reviewReply struct {
+	Id       string
+	Message  string
+	AuthorId string
+	Deleted  bool
+}
+
+// reviewReplies returns the owner replies to a review, newest first
+func reviewReplies(review ReviewFields) []reviewReply {
+	replies := []reviewReply{}
+	if review.Comment == nil {
+		return replies
+	}
+
+	for _, edge := range review.Comment.GetReplies().Edges {
+		if edge.Node != nil {
+			replies = append(replies, newReviewReply(edge.Node))
+		}
+	}
+	return replies
+}
+
+// newReviewReply converts a reply from any query that selects ReviewReplyFields
+func newReviewReply(post ReviewReplyFields) reviewReply {
+	active, ok := post.(interface {
+		GetMessage() string
+		GetAuthorId() string
+	})
+	if !ok {
+		return reviewReply{Id: post.GetId(), Deleted: true}
+	}
+	return reviewReply{Id: post.GetId(), Message: active.GetMessage(), AuthorId: active.GetAuthorId()}
+}
+
+// allReviewReplies returns every reply to a review, paging through the replies that didn't fit in
+// the first page returned with the review
+func allReviewReplies(client *MarketplaceClient, moduleId string, review ReviewFields) ([]reviewReply, error) {
+	if review.Comment == nil || !review.Comment.GetReplies().PageInfo.HasNextPage {
+		return reviewReplies(review), nil
+	}
+	return client.listReviewReplies(moduleId, review.Id)
+}
+
+func reviewComment(review ReviewFields) string {
+	if comment, ok := review.Comment.(*ReviewFieldsCommentActivePost); ok {
+		return comment.Message
+	}
+	return ""
+}
+
+func readModuleReviews(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*MarketplaceClient)
+	moduleId := d.Get("module_id").(string)
+	version := d.Get("version").(string)
+
+	reviews, err := client.listModuleReviews(moduleId)
+	if err != nil {
+		return fmt.Errorf("failed to list reviews of module %s: %w", moduleId, err)
+	}
+
+	flattened := []map[string]interface{}{}
+	for _, review := range reviews {
+		if version != "" && review.EntityVersion != version {
+			continue
+		}
+
+		all, err := allReviewReplies(client, moduleId, review)
+		if err != nil {
+			return fmt.Errorf("failed to list the replies to review %s: %w", review.Id, err)
+		}
+		replies := []map[string]interface{}{}
+		for _, reply := range all {
+			if reply.Deleted {
+				continue
+			}
+			replies = append(replies, map[string]interface{}{
+				"id":        reply.Id,
+				"comment":   reply.Message,
+				"author_id": reply.AuthorId,
+			})
+		}
+
+		flattened = append(flattened, map[string]interface{}{
+			"id":           review.Id,
+			"rating":       review.Rating,
+			"display_name": review.DisplayName,
+			"version":      review.EntityVersion,
+			"comment":      reviewComment(review),
+			"user_id":      review.UserId,
+			"created_at":   review.CreatedAt,
+			"replies":      replies,
+		})
+	}
+
+	if err := d.Set("reviews", flattened); err != nil {
+		return err
+	}
+	d.SetId(fmt.Sprintf("%s:%s", moduleId, version))
+	return nil
+}
+
+func moduleReviewsDataSource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"module_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"version": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only list reviews left on this module version",
+			},
+			"reviews": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"rating": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"display_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"comment": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"user_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"created_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"replies": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"comment": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"author_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Read: readModuleReviews,
+	}
+}
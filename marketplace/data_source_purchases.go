@@ -0,0 +1,182 @@
+package marketplace
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func purchaseSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"purchase_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"module_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"module_title": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"module_version": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"module_deleted": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"message": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"status": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"type": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"purchased_at": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"cancelled_at": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Set when a recurring purchase has been cancelled, 0 otherwise",
+		},
+	}
+}
+
+func flattenPurchase(purchase PurchaseFields) map[string]interface{} {
+	result := map[string]interface{}{
+		"purchase_id":    purchase.PurchaseId,
+		"module_id":      purchase.ModuleId,
+		"module_title":   "",
+		"module_version": "",
+		"module_deleted": false,
+		"message":        "",
+		"status":         string(purchase.Status),
+		"type":           string(purchase.Type),
+		"purchased_at":   int(purchase.PurchasedAt),
+		"cancelled_at":   0,
+	}
+
+	if purchase.CancelledAt != nil {
+		result["cancelled_at"] = int(*purchase.CancelledAt)
+	}
+
+	switch module := purchase.Module.(type) {
+	case *PurchaseFieldsModuleMarketplaceModule:
+		result["module_title"] = module.Title
+		result["module_version"] = module.Version
+	case *PurchaseFieldsModuleModuleDeletedMessage:
+		result["module_deleted"] = true
+		result["message"] = module.Message
+	}
+
+	return result
+}
+
+func readPurchases(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*MarketplaceClient)
+	status := d.Get("status").(string)
+	purchaseType := d.Get("type").(string)
+
+	purchases, err := client.listPurchases(PurchaseStatus(status), PurchaseType(purchaseType))
+	if err != nil {
+		return fmt.Errorf("failed to list purchases: %w", err)
+	}
+
+	flattened := make([]map[string]interface{}, 0, len(purchases))
+	for _, purchase := range purchases {
+		flattened = append(flattened, flattenPurchase(purchase))
+	}
+
+	if err := d.Set("purchases", flattened); err != nil {
+		return err
+	}
+	d.SetId(fmt.Sprintf("%s:%s", status, purchaseType))
+	return nil
+}
+
+func readModulePurchase(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*MarketplaceClient)
+	moduleId := d.Get("module_id").(string)
+
+	purchase, err := client.getUserPurchase(moduleId)
+	if err != nil {
+		return fmt.Errorf("failed to read the purchase of module %s: %w", moduleId, err)
+	}
+
+	d.Set("purchased", purchase != nil)
+	d.Set("active", purchase != nil && purchase.Status == PurchaseStatusActive)
+	if purchase != nil {
+		for key, value := range flattenPurchase(*purchase) {
+			if key == "module_id" {
+				continue
+			}
+			d.Set(key, value)
+		}
+	}
+	d.SetId(moduleId)
+	return nil
+}
+
+func purchasesDataSource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(PurchaseStatusActive),
+					string(PurchaseStatusInactive),
+				}, false),
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(PurchaseTypeOneTime),
+					string(PurchaseTypeRecurring),
+				}, false),
+			},
+			"purchases": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: purchaseSchema(),
+				},
+			},
+		},
+		Read: readPurchases,
+	}
+}
+
+func modulePurchaseDataSource() *schema.Resource {
+	fields := purchaseSchema()
+	fields["module_id"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+	}
+	fields["purchased"] = &schema.Schema{
+		Type:     schema.TypeBool,
+		Computed: true,
+	}
+	fields["active"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Computed:    true,
+		Description: "Whether the caller has an active purchase of the module",
+	}
+
+	return &schema.Resource{
+		Schema: fields,
+		Read:   readModulePurchase,
+	}
+}
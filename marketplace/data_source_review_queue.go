@@ -0,0 +1,91 @@
+package marketplace
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func readReviewQueue(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*MarketplaceClient)
+	assignedToMe := d.Get("assigned_to_me").(bool)
+
+	modules, err := client.listDraftModulesInManualReview(assignedToMe)
+	if err != nil {
+		return fmt.Errorf("failed to list drafts in manual review: %w", err)
+	}
+
+	flattened := make([]map[string]interface{}, 0, len(modules))
+	for _, module := range modules {
+		flattened = append(flattened, map[string]interface{}{
+			"module_id":         module.Id,
+			"title":             module.Title,
+			"description":       module.Description,
+			"category":          string(module.Category),
+			"parent_module_id":  module.ParentModuleId,
+			"assigned_reviewer": module.AssignedReviewer,
+			"organization_id":   module.Organization.Id,
+			"organization_name": module.Organization.Name,
+		})
+	}
+
+	if err := d.Set("drafts", flattened); err != nil {
+		return err
+	}
+	d.SetId(strconv.FormatBool(assignedToMe))
+	return nil
+}
+
+func reviewQueueDataSource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"assigned_to_me": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"drafts": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"module_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"title": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"category": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"parent_module_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"assigned_reviewer": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"organization_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"organization_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+		Read: readReviewQueue,
+	}
+}
@@ -0,0 +1,109 @@
+package marketplace
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// validateISODate accepts a calendar date (2006-01-02) or a full RFC3339 timestamp
+func validateISODate(value interface{}, key string) ([]string, []error) {
+	date := value.(string)
+	if _, err := time.Parse("2006-01-02", date); err == nil {
+		return nil, nil
+	}
+	if _, err := time.Parse(time.RFC3339, date); err == nil {
+		return nil, nil
+	}
+	return nil, []error{fmt.Errorf("%s must be an ISO-8601 date such as 2022-01-31 or 2022-01-31T00:00:00Z, got %q", key, date)}
+}
+
+func readStats(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*MarketplaceClient).gqlClient
+	from := d.Get("from").(string)
+	to := d.Get("to").(string)
+
+	input := ModuleStatsInput{}
+	if from != "" && to != "" {
+		input.DateRange = &ModuleStatsDateRangeInput{From: from, To: to}
+	}
+
+	resp, err := GetModuleStats(context.Background(), client, input)
+	if err != nil {
+		return fmt.Errorf("failed to read marketplace statistics: %w", err)
+	}
+	stats := resp.ModuleStats
+
+	categories := map[string]interface{}{}
+	for _, category := range stats.Categories {
+		categories[string(category.Category)] = category.Total
+	}
+	scopes := map[string]interface{}{}
+	for _, scope := range stats.Scopes {
+		scopes[string(scope.Scope)] = scope.Total
+	}
+
+	d.Set("total", stats.Total)
+	if err := d.Set("categories", categories); err != nil {
+		return err
+	}
+	if err := d.Set("scopes", scopes); err != nil {
+		return err
+	}
+	d.Set("range_from", stats.DateRange.From)
+	d.Set("range_to", stats.DateRange.To)
+	d.SetId(fmt.Sprintf("%s:%s", from, to))
+	return nil
+}
+
+func statsDataSource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"from": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"to"},
+				ValidateFunc: validateISODate,
+			},
+			"to": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"from"},
+				ValidateFunc: validateISODate,
+			},
+			"total": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"categories": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "Number of modules for each module category",
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+			"scopes": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "Number of modules for each module scope",
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+			"range_from": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Start of the date range the service used",
+			},
+			"range_to": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "End of the date range the service used",
+			},
+		},
+		Read: readStats,
+	}
+}
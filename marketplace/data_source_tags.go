@@ -0,0 +1,103 @@
+package marketplace
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var moduleCategories = []string{
+	string(ModuleCategoryAppTile),
+	string(ModuleCategoryConsent),
+	string(ModuleCategoryDomainOntology),
+	string(ModuleCategoryInsightsLayout),
+	string(ModuleCategoryNotebook),
+	string(ModuleCategoryPatientViewerLayout),
+	string(ModuleCategoryProcessOntology),
+	string(ModuleCategoryProgramEnrollment),
+	string(ModuleCategoryProgramTemplate),
+	string(ModuleCategoryReportExtractor),
+	string(ModuleCategorySearchLayout),
+	string(ModuleCategorySurvey),
+	string(ModuleCategoryWellnessOffering),
+	string(ModuleCategoryWorkflow),
+}
+
+func readTags(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*MarketplaceClient)
+	org := d.Get("organization").(bool)
+	category := d.Get("category").(string)
+	value := d.Get("value").(string)
+
+	tags, err := client.listModuleTags(org, ModuleCategory(category), value)
+	if err != nil {
+		return fmt.Errorf("failed to list module tags: %w", err)
+	}
+
+	flattened := make([]map[string]interface{}, 0, len(tags))
+	values := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		flattened = append(flattened, map[string]interface{}{
+			"value": tag.Value,
+			"count": tag.Count,
+		})
+		values = append(values, tag.Value)
+	}
+
+	if err := d.Set("tags", flattened); err != nil {
+		return err
+	}
+	if err := d.Set("values", values); err != nil {
+		return err
+	}
+	d.SetId(fmt.Sprintf("%t:%s:%s", org, category, value))
+	return nil
+}
+
+func tagsDataSource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"organization": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "List the organization's tags (orgModuleTags) instead of the public ones (moduleTags)",
+			},
+			"category": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(moduleCategories, false),
+			},
+			"value": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only list tags matching this value",
+			},
+			"tags": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"value": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"values": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+		Read: readTags,
+	}
+}
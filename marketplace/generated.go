@@ -12,11 +12,14 @@ import (
 
 // AppTileModule includes the GraphQL fields of MarketplaceModule requested by the fragment AppTileModule.
 type AppTileModule struct {
-	Title       string                                     `json:"title"`
-	Description string                                     `json:"description"`
-	Version     string                                     `json:"version"`
-	Source      AppTileModuleSourceMarketplaceModuleSource `json:"-"`
-	IconV2      *AppTileModuleIconV2MarketplaceModuleImage `json:"iconV2"`
+	Title           string                                                      `json:"title"`
+	Description     string                                                      `json:"description"`
+	Version         string                                                      `json:"version"`
+	Tags            []string                                                    `json:"tags"`
+	Prices          []AppTileModulePricesModulePrice                            `json:"prices"`
+	Source          AppTileModuleSourceMarketplaceModuleSource                  `json:"-"`
+	IconV2          *AppTileModuleIconV2MarketplaceModuleImage                  `json:"iconV2"`
+	PreviewImagesV2 *AppTileModulePreviewImagesV2MarketplaceModulePreviewImages `json:"previewImagesV2"`
 }
 
 // GetTitle returns AppTileModule.Title, and is useful for accessing the field via an interface.
@@ -28,12 +31,23 @@ func (v *AppTileModule) GetDescription() string { return v.Description }
 // GetVersion returns AppTileModule.Version, and is useful for accessing the field via an interface.
 func (v *AppTileModule) GetVersion() string { return v.Version }
 
+// GetTags returns AppTileModule.Tags, and is useful for accessing the field via an interface.
+func (v *AppTileModule) GetTags() []string { return v.Tags }
+
+// GetPrices returns AppTileModule.Prices, and is useful for accessing the field via an interface.
+func (v *AppTileModule) GetPrices() []AppTileModulePricesModulePrice { return v.Prices }
+
 // GetSource returns AppTileModule.Source, and is useful for accessing the field via an interface.
 func (v *AppTileModule) GetSource() AppTileModuleSourceMarketplaceModuleSource { return v.Source }
 
 // GetIconV2 returns AppTileModule.IconV2, and is useful for accessing the field via an interface.
 func (v *AppTileModule) GetIconV2() *AppTileModuleIconV2MarketplaceModuleImage { return v.IconV2 }
 
+// GetPreviewImagesV2 returns AppTileModule.PreviewImagesV2, and is useful for accessing the field via an interface.
+func (v *AppTileModule) GetPreviewImagesV2() *AppTileModulePreviewImagesV2MarketplaceModulePreviewImages {
+	return v.PreviewImagesV2
+}
+
 func (v *AppTileModule) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
@@ -74,9 +88,15 @@ type __premarshalAppTileModule struct {
 
 	Version string `json:"version"`
 
+	Tags []string `json:"tags"`
+
+	Prices []AppTileModulePricesModulePrice `json:"prices"`
+
 	Source json.RawMessage `json:"source"`
 
 	IconV2 *AppTileModuleIconV2MarketplaceModuleImage `json:"iconV2"`
+
+	PreviewImagesV2 *AppTileModulePreviewImagesV2MarketplaceModulePreviewImages `json:"previewImagesV2"`
 }
 
 func (v *AppTileModule) MarshalJSON() ([]byte, error) {
@@ -93,6 +113,8 @@ func (v *AppTileModule) __premarshalJSON() (*__premarshalAppTileModule, error) {
 	retval.Title = v.Title
 	retval.Description = v.Description
 	retval.Version = v.Version
+	retval.Tags = v.Tags
+	retval.Prices = v.Prices
 	{
 
 		dst := &retval.Source
@@ -106,6 +128,7 @@ func (v *AppTileModule) __premarshalJSON() (*__premarshalAppTileModule, error) {
 		}
 	}
 	retval.IconV2 = v.IconV2
+	retval.PreviewImagesV2 = v.PreviewImagesV2
 	return &retval, nil
 }
 
@@ -125,6 +148,45 @@ func (v *AppTileModuleIconV2MarketplaceModuleImage) GetFileName() string { retur
 // GetFileExtension returns AppTileModuleIconV2MarketplaceModuleImage.FileExtension, and is useful for accessing the field via an interface.
 func (v *AppTileModuleIconV2MarketplaceModuleImage) GetFileExtension() string { return v.FileExtension }
 
+// AppTileModulePreviewImagesV2MarketplaceModulePreviewImages includes the requested fields of the GraphQL type MarketplaceModulePreviewImages.
+type AppTileModulePreviewImagesV2MarketplaceModulePreviewImages struct {
+	Images []AppTileModulePreviewImagesV2MarketplaceModulePreviewImagesImagesMarketplaceModulePreviewImage `json:"images"`
+}
+
+// GetImages returns AppTileModulePreviewImagesV2MarketplaceModulePreviewImages.Images, and is useful for accessing the field via an interface.
+func (v *AppTileModulePreviewImagesV2MarketplaceModulePreviewImages) GetImages() []AppTileModulePreviewImagesV2MarketplaceModulePreviewImagesImagesMarketplaceModulePreviewImage {
+	return v.Images
+}
+
+// AppTileModulePreviewImagesV2MarketplaceModulePreviewImagesImagesMarketplaceModulePreviewImage includes the requested fields of the GraphQL type MarketplaceModulePreviewImage.
+type AppTileModulePreviewImagesV2MarketplaceModulePreviewImagesImagesMarketplaceModulePreviewImage struct {
+	Url         string `json:"url"`
+	Description string `json:"description"`
+}
+
+// GetUrl returns AppTileModulePreviewImagesV2MarketplaceModulePreviewImagesImagesMarketplaceModulePreviewImage.Url, and is useful for accessing the field via an interface.
+func (v *AppTileModulePreviewImagesV2MarketplaceModulePreviewImagesImagesMarketplaceModulePreviewImage) GetUrl() string {
+	return v.Url
+}
+
+// GetDescription returns AppTileModulePreviewImagesV2MarketplaceModulePreviewImagesImagesMarketplaceModulePreviewImage.Description, and is useful for accessing the field via an interface.
+func (v *AppTileModulePreviewImagesV2MarketplaceModulePreviewImagesImagesMarketplaceModulePreviewImage) GetDescription() string {
+	return v.Description
+}
+
+// AppTileModulePricesModulePrice includes the requested fields of the GraphQL type ModulePrice.
+type AppTileModulePricesModulePrice struct {
+	// Amount in pennies USD
+	Amount   int             `json:"amount"`
+	Interval PaymentInterval `json:"interval"`
+}
+
+// GetAmount returns AppTileModulePricesModulePrice.Amount, and is useful for accessing the field via an interface.
+func (v *AppTileModulePricesModulePrice) GetAmount() int { return v.Amount }
+
+// GetInterval returns AppTileModulePricesModulePrice.Interval, and is useful for accessing the field via an interface.
+func (v *AppTileModulePricesModulePrice) GetInterval() PaymentInterval { return v.Interval }
+
 // AppTileModuleSourceAppTile includes the requested fields of the GraphQL type AppTile.
 type AppTileModuleSourceAppTile struct {
 	Typename string `json:"__typename"`
@@ -482,6 +544,116 @@ type AppTileModuleSourceWorkflow struct {
 // GetTypename returns AppTileModuleSourceWorkflow.Typename, and is useful for accessing the field via an interface.
 func (v *AppTileModuleSourceWorkflow) GetTypename() string { return v.Typename }
 
+// ApproveModulePublishApproveModulePublishApproveModulePublishResponse includes the requested fields of the GraphQL type ApproveModulePublishResponse.
+type ApproveModulePublishApproveModulePublishApproveModulePublishResponse struct {
+	Id      string                                                                                           `json:"id"`
+	Version ApproveModulePublishApproveModulePublishApproveModulePublishResponseVersionModuleVersionResponse `json:"version"`
+}
+
+// GetId returns ApproveModulePublishApproveModulePublishApproveModulePublishResponse.Id, and is useful for accessing the field via an interface.
+func (v *ApproveModulePublishApproveModulePublishApproveModulePublishResponse) GetId() string {
+	return v.Id
+}
+
+// GetVersion returns ApproveModulePublishApproveModulePublishApproveModulePublishResponse.Version, and is useful for accessing the field via an interface.
+func (v *ApproveModulePublishApproveModulePublishApproveModulePublishResponse) GetVersion() ApproveModulePublishApproveModulePublishApproveModulePublishResponseVersionModuleVersionResponse {
+	return v.Version
+}
+
+// ApproveModulePublishApproveModulePublishApproveModulePublishResponseVersionModuleVersionResponse includes the requested fields of the GraphQL type ModuleVersionResponse.
+type ApproveModulePublishApproveModulePublishApproveModulePublishResponseVersionModuleVersionResponse struct {
+	Version string `json:"version"`
+}
+
+// GetVersion returns ApproveModulePublishApproveModulePublishApproveModulePublishResponseVersionModuleVersionResponse.Version, and is useful for accessing the field via an interface.
+func (v *ApproveModulePublishApproveModulePublishApproveModulePublishResponseVersionModuleVersionResponse) GetVersion() string {
+	return v.Version
+}
+
+type ApproveModulePublishInput struct {
+	Entitlements []Entitlement `json:"entitlements"`
+	ModuleId     string        `json:"moduleId"`
+	Notes        string        `json:"notes"`
+}
+
+// GetEntitlements returns ApproveModulePublishInput.Entitlements, and is useful for accessing the field via an interface.
+func (v *ApproveModulePublishInput) GetEntitlements() []Entitlement { return v.Entitlements }
+
+// GetModuleId returns ApproveModulePublishInput.ModuleId, and is useful for accessing the field via an interface.
+func (v *ApproveModulePublishInput) GetModuleId() string { return v.ModuleId }
+
+// GetNotes returns ApproveModulePublishInput.Notes, and is useful for accessing the field via an interface.
+func (v *ApproveModulePublishInput) GetNotes() string { return v.Notes }
+
+// ApproveModulePublishResponse is returned by ApproveModulePublish on success.
+type ApproveModulePublishResponse struct {
+	// Approves draft module review and publishes module to the marketplace
+	ApproveModulePublish ApproveModulePublishApproveModulePublishApproveModulePublishResponse `json:"approveModulePublish"`
+}
+
+// GetApproveModulePublish returns ApproveModulePublishResponse.ApproveModulePublish, and is useful for accessing the field via an interface.
+func (v *ApproveModulePublishResponse) GetApproveModulePublish() ApproveModulePublishApproveModulePublishApproveModulePublishResponse {
+	return v.ApproveModulePublish
+}
+
+// AssignDraftModuleForReviewAssignDraftModuleForReviewAssignDraftModuleForReviewResponse includes the requested fields of the GraphQL type AssignDraftModuleForReviewResponse.
+type AssignDraftModuleForReviewAssignDraftModuleForReviewAssignDraftModuleForReviewResponse struct {
+	ModuleId         string `json:"moduleId"`
+	AssignedReviewer string `json:"assignedReviewer"`
+}
+
+// GetModuleId returns AssignDraftModuleForReviewAssignDraftModuleForReviewAssignDraftModuleForReviewResponse.ModuleId, and is useful for accessing the field via an interface.
+func (v *AssignDraftModuleForReviewAssignDraftModuleForReviewAssignDraftModuleForReviewResponse) GetModuleId() string {
+	return v.ModuleId
+}
+
+// GetAssignedReviewer returns AssignDraftModuleForReviewAssignDraftModuleForReviewAssignDraftModuleForReviewResponse.AssignedReviewer, and is useful for accessing the field via an interface.
+func (v *AssignDraftModuleForReviewAssignDraftModuleForReviewAssignDraftModuleForReviewResponse) GetAssignedReviewer() string {
+	return v.AssignedReviewer
+}
+
+// AssignDraftModuleForReviewResponse is returned by AssignDraftModuleForReview on success.
+type AssignDraftModuleForReviewResponse struct {
+	// Assigns draft module to the current user for manual review
+	AssignDraftModuleForReview AssignDraftModuleForReviewAssignDraftModuleForReviewAssignDraftModuleForReviewResponse `json:"assignDraftModuleForReview"`
+}
+
+// GetAssignDraftModuleForReview returns AssignDraftModuleForReviewResponse.AssignDraftModuleForReview, and is useful for accessing the field via an interface.
+func (v *AssignDraftModuleForReviewResponse) GetAssignDraftModuleForReview() AssignDraftModuleForReviewAssignDraftModuleForReviewAssignDraftModuleForReviewResponse {
+	return v.AssignDraftModuleForReview
+}
+
+// CancelModulePublishCancelModulePublishCancelModulePublishResponse includes the requested fields of the GraphQL type CancelModulePublishResponse.
+type CancelModulePublishCancelModulePublishCancelModulePublishResponse struct {
+	Id string `json:"id"`
+}
+
+// GetId returns CancelModulePublishCancelModulePublishCancelModulePublishResponse.Id, and is useful for accessing the field via an interface.
+func (v *CancelModulePublishCancelModulePublishCancelModulePublishResponse) GetId() string {
+	return v.Id
+}
+
+// CancelModulePublishResponse is returned by CancelModulePublish on success.
+type CancelModulePublishResponse struct {
+	// Module owner cancels publish review process
+	CancelModulePublish CancelModulePublishCancelModulePublishCancelModulePublishResponse `json:"cancelModulePublish"`
+}
+
+// GetCancelModulePublish returns CancelModulePublishResponse.CancelModulePublish, and is useful for accessing the field via an interface.
+func (v *CancelModulePublishResponse) GetCancelModulePublish() CancelModulePublishCancelModulePublishCancelModulePublishResponse {
+	return v.CancelModulePublish
+}
+
+type ConnectAccountStatus string
+
+const (
+	ConnectAccountStatusEnabled         ConnectAccountStatus = "ENABLED"
+	ConnectAccountStatusError           ConnectAccountStatus = "ERROR"
+	ConnectAccountStatusPayoutsDisabled ConnectAccountStatus = "PAYOUTS_DISABLED"
+	ConnectAccountStatusPending         ConnectAccountStatus = "PENDING"
+	ConnectAccountStatusWarning         ConnectAccountStatus = "WARNING"
+)
+
 // CreateDraftModuleCreateDraftModuleCreateDraftModuleResponse includes the requested fields of the GraphQL type CreateDraftModuleResponse.
 type CreateDraftModuleCreateDraftModuleCreateDraftModuleResponse struct {
 	Id string `json:"id"`
@@ -495,7 +667,7 @@ type CreateDraftModuleInput struct {
 	Description string         `json:"description"`
 	Icon        string         `json:"icon"`
 	// A unique identifier to use for the new module. If not provided, one will be generated.
-	Id               string                  `json:"id"`
+	Id               string                  `json:"id,omitempty"`
 	Languages        []string                `json:"languages"`
 	LicenseDetails   LicenseDetailsInput     `json:"licenseDetails"`
 	ParentModuleId   string                  `json:"parentModuleId"`
@@ -568,6 +740,162 @@ func (v *CreateDraftModuleResponse) GetCreateDraftModule() CreateDraftModuleCrea
 	return v.CreateDraftModule
 }
 
+// CreateMarketplaceReviewReplyCreateMarketplaceReviewReplyReview includes the requested fields of the GraphQL type Review.
+type CreateMarketplaceReviewReplyCreateMarketplaceReviewReplyReview struct {
+	ReviewFields `json:"-"`
+}
+
+// GetId returns CreateMarketplaceReviewReplyCreateMarketplaceReviewReplyReview.Id, and is useful for accessing the field via an interface.
+func (v *CreateMarketplaceReviewReplyCreateMarketplaceReviewReplyReview) GetId() string {
+	return v.ReviewFields.Id
+}
+
+// GetRating returns CreateMarketplaceReviewReplyCreateMarketplaceReviewReplyReview.Rating, and is useful for accessing the field via an interface.
+func (v *CreateMarketplaceReviewReplyCreateMarketplaceReviewReplyReview) GetRating() int {
+	return v.ReviewFields.Rating
+}
+
+// GetDisplayName returns CreateMarketplaceReviewReplyCreateMarketplaceReviewReplyReview.DisplayName, and is useful for accessing the field via an interface.
+func (v *CreateMarketplaceReviewReplyCreateMarketplaceReviewReplyReview) GetDisplayName() string {
+	return v.ReviewFields.DisplayName
+}
+
+// GetEntityVersion returns CreateMarketplaceReviewReplyCreateMarketplaceReviewReplyReview.EntityVersion, and is useful for accessing the field via an interface.
+func (v *CreateMarketplaceReviewReplyCreateMarketplaceReviewReplyReview) GetEntityVersion() string {
+	return v.ReviewFields.EntityVersion
+}
+
+// GetCreatedAt returns CreateMarketplaceReviewReplyCreateMarketplaceReviewReplyReview.CreatedAt, and is useful for accessing the field via an interface.
+func (v *CreateMarketplaceReviewReplyCreateMarketplaceReviewReplyReview) GetCreatedAt() string {
+	return v.ReviewFields.CreatedAt
+}
+
+// GetUserId returns CreateMarketplaceReviewReplyCreateMarketplaceReviewReplyReview.UserId, and is useful for accessing the field via an interface.
+func (v *CreateMarketplaceReviewReplyCreateMarketplaceReviewReplyReview) GetUserId() string {
+	return v.ReviewFields.UserId
+}
+
+// GetComment returns CreateMarketplaceReviewReplyCreateMarketplaceReviewReplyReview.Comment, and is useful for accessing the field via an interface.
+func (v *CreateMarketplaceReviewReplyCreateMarketplaceReviewReplyReview) GetComment() ReviewFieldsCommentPost {
+	return v.ReviewFields.Comment
+}
+
+func (v *CreateMarketplaceReviewReplyCreateMarketplaceReviewReplyReview) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*CreateMarketplaceReviewReplyCreateMarketplaceReviewReplyReview
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.CreateMarketplaceReviewReplyCreateMarketplaceReviewReplyReview = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.ReviewFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalCreateMarketplaceReviewReplyCreateMarketplaceReviewReplyReview struct {
+	Id string `json:"id"`
+
+	Rating int `json:"rating"`
+
+	DisplayName string `json:"displayName"`
+
+	EntityVersion string `json:"entityVersion"`
+
+	CreatedAt string `json:"createdAt"`
+
+	UserId string `json:"userId"`
+
+	Comment json.RawMessage `json:"comment"`
+}
+
+func (v *CreateMarketplaceReviewReplyCreateMarketplaceReviewReplyReview) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *CreateMarketplaceReviewReplyCreateMarketplaceReviewReplyReview) __premarshalJSON() (*__premarshalCreateMarketplaceReviewReplyCreateMarketplaceReviewReplyReview, error) {
+	var retval __premarshalCreateMarketplaceReviewReplyCreateMarketplaceReviewReplyReview
+
+	retval.Id = v.ReviewFields.Id
+	retval.Rating = v.ReviewFields.Rating
+	retval.DisplayName = v.ReviewFields.DisplayName
+	retval.EntityVersion = v.ReviewFields.EntityVersion
+	retval.CreatedAt = v.ReviewFields.CreatedAt
+	retval.UserId = v.ReviewFields.UserId
+	{
+
+		dst := &retval.Comment
+		src := v.ReviewFields.Comment
+		var err error
+		*dst, err = __marshalReviewFieldsCommentPost(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"Unable to marshal CreateMarketplaceReviewReplyCreateMarketplaceReviewReplyReview.ReviewFields.Comment: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+type CreateMarketplaceReviewReplyInput struct {
+	Comment  string `json:"comment"`
+	ModuleId string `json:"moduleId"`
+	RatingId string `json:"ratingId"`
+}
+
+// GetComment returns CreateMarketplaceReviewReplyInput.Comment, and is useful for accessing the field via an interface.
+func (v *CreateMarketplaceReviewReplyInput) GetComment() string { return v.Comment }
+
+// GetModuleId returns CreateMarketplaceReviewReplyInput.ModuleId, and is useful for accessing the field via an interface.
+func (v *CreateMarketplaceReviewReplyInput) GetModuleId() string { return v.ModuleId }
+
+// GetRatingId returns CreateMarketplaceReviewReplyInput.RatingId, and is useful for accessing the field via an interface.
+func (v *CreateMarketplaceReviewReplyInput) GetRatingId() string { return v.RatingId }
+
+// CreateMarketplaceReviewReplyResponse is returned by CreateMarketplaceReviewReply on success.
+type CreateMarketplaceReviewReplyResponse struct {
+	CreateMarketplaceReviewReply CreateMarketplaceReviewReplyCreateMarketplaceReviewReplyReview `json:"createMarketplaceReviewReply"`
+}
+
+// GetCreateMarketplaceReviewReply returns CreateMarketplaceReviewReplyResponse.CreateMarketplaceReviewReply, and is useful for accessing the field via an interface.
+func (v *CreateMarketplaceReviewReplyResponse) GetCreateMarketplaceReviewReply() CreateMarketplaceReviewReplyCreateMarketplaceReviewReplyReview {
+	return v.CreateMarketplaceReviewReply
+}
+
+// DeleteDraftModuleDeleteDraftModuleDeleteDraftModuleResponse includes the requested fields of the GraphQL type DeleteDraftModuleResponse.
+type DeleteDraftModuleDeleteDraftModuleDeleteDraftModuleResponse struct {
+	Id string `json:"id"`
+}
+
+// GetId returns DeleteDraftModuleDeleteDraftModuleDeleteDraftModuleResponse.Id, and is useful for accessing the field via an interface.
+func (v *DeleteDraftModuleDeleteDraftModuleDeleteDraftModuleResponse) GetId() string { return v.Id }
+
+// DeleteDraftModuleResponse is returned by DeleteDraftModule on success.
+type DeleteDraftModuleResponse struct {
+	DeleteDraftModule DeleteDraftModuleDeleteDraftModuleDeleteDraftModuleResponse `json:"deleteDraftModule"`
+}
+
+// GetDeleteDraftModule returns DeleteDraftModuleResponse.DeleteDraftModule, and is useful for accessing the field via an interface.
+func (v *DeleteDraftModuleResponse) GetDeleteDraftModule() DeleteDraftModuleDeleteDraftModuleDeleteDraftModuleResponse {
+	return v.DeleteDraftModule
+}
+
 // DeleteModuleDeleteModuleDeleteModuleResponse includes the requested fields of the GraphQL type DeleteModuleResponse.
 type DeleteModuleDeleteModuleDeleteModuleResponse struct {
 	Id string `json:"id"`
@@ -597,6 +925,35 @@ func (v *DeleteModuleResponse) GetDeleteModule() DeleteModuleDeleteModuleDeleteM
 	return v.DeleteModule
 }
 
+// DenyModulePublishDenyModulePublishDenyModulePublishResponse includes the requested fields of the GraphQL type DenyModulePublishResponse.
+type DenyModulePublishDenyModulePublishDenyModulePublishResponse struct {
+	Id string `json:"id"`
+}
+
+// GetId returns DenyModulePublishDenyModulePublishDenyModulePublishResponse.Id, and is useful for accessing the field via an interface.
+func (v *DenyModulePublishDenyModulePublishDenyModulePublishResponse) GetId() string { return v.Id }
+
+type DenyModulePublishInput struct {
+	ModuleId string `json:"moduleId"`
+	Notes    string `json:"notes"`
+}
+
+// GetModuleId returns DenyModulePublishInput.ModuleId, and is useful for accessing the field via an interface.
+func (v *DenyModulePublishInput) GetModuleId() string { return v.ModuleId }
+
+// GetNotes returns DenyModulePublishInput.Notes, and is useful for accessing the field via an interface.
+func (v *DenyModulePublishInput) GetNotes() string { return v.Notes }
+
+// DenyModulePublishResponse is returned by DenyModulePublish on success.
+type DenyModulePublishResponse struct {
+	DenyModulePublish DenyModulePublishDenyModulePublishDenyModulePublishResponse `json:"denyModulePublish"`
+}
+
+// GetDenyModulePublish returns DenyModulePublishResponse.DenyModulePublish, and is useful for accessing the field via an interface.
+func (v *DenyModulePublishResponse) GetDenyModulePublish() DenyModulePublishDenyModulePublishDenyModulePublishResponse {
+	return v.DenyModulePublish
+}
+
 type DraftModulePriceInput struct {
 	// Amount in pennies USD
 	Amount   int             `json:"amount"`
@@ -609,6 +966,20 @@ func (v *DraftModulePriceInput) GetAmount() int { return v.Amount }
 // GetInterval returns DraftModulePriceInput.Interval, and is useful for accessing the field via an interface.
 func (v *DraftModulePriceInput) GetInterval() PaymentInterval { return v.Interval }
 
+type DraftModulesInManualReviewInput struct {
+	AssignedToMe bool `json:"assignedToMe"`
+}
+
+// GetAssignedToMe returns DraftModulesInManualReviewInput.AssignedToMe, and is useful for accessing the field via an interface.
+func (v *DraftModulesInManualReviewInput) GetAssignedToMe() bool { return v.AssignedToMe }
+
+type Entitlement string
+
+const (
+	EntitlementLifeascent Entitlement = "lifeAscent"
+	EntitlementLifeplus   Entitlement = "lifePlus"
+)
+
 type FileWithDescription struct {
 	Description string `json:"description"`
 	File        string `json:"file"`
@@ -663,399 +1034,8051 @@ func (v *FinalizeUploadInput) GetPriority() int { return v.Priority }
 // GetType returns FinalizeUploadInput.Type, and is useful for accessing the field via an interface.
 func (v *FinalizeUploadInput) GetType() UploadType { return v.Type }
 
-// GetPublishedModuleMyModuleMarketplaceModule includes the requested fields of the GraphQL type MarketplaceModule.
-type GetPublishedModuleMyModuleMarketplaceModule struct {
-	AppTileModule `json:"-"`
+// GetBillingPortalLinkGetBillingPortalLinkGetBillingPortalLinkResponse includes the requested fields of the GraphQL type GetBillingPortalLinkResponse.
+type GetBillingPortalLinkGetBillingPortalLinkGetBillingPortalLinkResponse struct {
+	Url string `json:"url"`
 }
 
-// GetTitle returns GetPublishedModuleMyModuleMarketplaceModule.Title, and is useful for accessing the field via an interface.
-func (v *GetPublishedModuleMyModuleMarketplaceModule) GetTitle() string { return v.AppTileModule.Title }
-
-// GetDescription returns GetPublishedModuleMyModuleMarketplaceModule.Description, and is useful for accessing the field via an interface.
-func (v *GetPublishedModuleMyModuleMarketplaceModule) GetDescription() string {
-	return v.AppTileModule.Description
+// GetUrl returns GetBillingPortalLinkGetBillingPortalLinkGetBillingPortalLinkResponse.Url, and is useful for accessing the field via an interface.
+func (v *GetBillingPortalLinkGetBillingPortalLinkGetBillingPortalLinkResponse) GetUrl() string {
+	return v.Url
 }
 
-// GetVersion returns GetPublishedModuleMyModuleMarketplaceModule.Version, and is useful for accessing the field via an interface.
-func (v *GetPublishedModuleMyModuleMarketplaceModule) GetVersion() string {
-	return v.AppTileModule.Version
+type GetBillingPortalLinkInput struct {
+	Domain string `json:"domain,omitempty"`
+	// Path to return to when leaving the billing portal
+	Success string `json:"success"`
 }
 
-// GetSource returns GetPublishedModuleMyModuleMarketplaceModule.Source, and is useful for accessing the field via an interface.
-func (v *GetPublishedModuleMyModuleMarketplaceModule) GetSource() AppTileModuleSourceMarketplaceModuleSource {
-	return v.AppTileModule.Source
+// GetDomain returns GetBillingPortalLinkInput.Domain, and is useful for accessing the field via an interface.
+func (v *GetBillingPortalLinkInput) GetDomain() string { return v.Domain }
+
+// GetSuccess returns GetBillingPortalLinkInput.Success, and is useful for accessing the field via an interface.
+func (v *GetBillingPortalLinkInput) GetSuccess() string { return v.Success }
+
+// GetBillingPortalLinkResponse is returned by GetBillingPortalLink on success.
+type GetBillingPortalLinkResponse struct {
+	GetBillingPortalLink GetBillingPortalLinkGetBillingPortalLinkGetBillingPortalLinkResponse `json:"getBillingPortalLink"`
 }
 
-// GetIconV2 returns GetPublishedModuleMyModuleMarketplaceModule.IconV2, and is useful for accessing the field via an interface.
-func (v *GetPublishedModuleMyModuleMarketplaceModule) GetIconV2() *AppTileModuleIconV2MarketplaceModuleImage {
-	return v.AppTileModule.IconV2
+// GetGetBillingPortalLink returns GetBillingPortalLinkResponse.GetBillingPortalLink, and is useful for accessing the field via an interface.
+func (v *GetBillingPortalLinkResponse) GetGetBillingPortalLink() GetBillingPortalLinkGetBillingPortalLinkGetBillingPortalLinkResponse {
+	return v.GetBillingPortalLink
 }
 
-func (v *GetPublishedModuleMyModuleMarketplaceModule) UnmarshalJSON(b []byte) error {
+// GetConnectAccountConnectAccount includes the requested fields of the GraphQL type ConnectAccount.
+type GetConnectAccountConnectAccount struct {
+	Status ConnectAccountStatus `json:"status"`
+}
 
-	if string(b) == "null" {
-		return nil
-	}
+// GetStatus returns GetConnectAccountConnectAccount.Status, and is useful for accessing the field via an interface.
+func (v *GetConnectAccountConnectAccount) GetStatus() ConnectAccountStatus { return v.Status }
 
-	var firstPass struct {
-		*GetPublishedModuleMyModuleMarketplaceModule
-		graphql.NoUnmarshalJSON
-	}
-	firstPass.GetPublishedModuleMyModuleMarketplaceModule = v
+// GetConnectAccountLinkGetConnectAccountLinkGetConnectAccountLinkResponse includes the requested fields of the GraphQL type GetConnectAccountLinkResponse.
+type GetConnectAccountLinkGetConnectAccountLinkGetConnectAccountLinkResponse struct {
+	Url string `json:"url"`
+}
 
-	err := json.Unmarshal(b, &firstPass)
-	if err != nil {
-		return err
-	}
+// GetUrl returns GetConnectAccountLinkGetConnectAccountLinkGetConnectAccountLinkResponse.Url, and is useful for accessing the field via an interface.
+func (v *GetConnectAccountLinkGetConnectAccountLinkGetConnectAccountLinkResponse) GetUrl() string {
+	return v.Url
+}
 
-	err = json.Unmarshal(
-		b, &v.AppTileModule)
-	if err != nil {
-		return err
-	}
-	return nil
+type GetConnectAccountLinkInput struct {
+	Domain string `json:"domain,omitempty"`
+	// path for if the link generate is expired, will redirect them here
+	Expired string `json:"expired"`
+	// path for successfully loading up account dashboard and returning
+	Success string `json:"success"`
 }
 
-type __premarshalGetPublishedModuleMyModuleMarketplaceModule struct {
-	Title string `json:"title"`
+// GetDomain returns GetConnectAccountLinkInput.Domain, and is useful for accessing the field via an interface.
+func (v *GetConnectAccountLinkInput) GetDomain() string { return v.Domain }
 
-	Description string `json:"description"`
+// GetExpired returns GetConnectAccountLinkInput.Expired, and is useful for accessing the field via an interface.
+func (v *GetConnectAccountLinkInput) GetExpired() string { return v.Expired }
 
-	Version string `json:"version"`
+// GetSuccess returns GetConnectAccountLinkInput.Success, and is useful for accessing the field via an interface.
+func (v *GetConnectAccountLinkInput) GetSuccess() string { return v.Success }
 
-	Source json.RawMessage `json:"source"`
+// GetConnectAccountLinkResponse is returned by GetConnectAccountLink on success.
+type GetConnectAccountLinkResponse struct {
+	GetConnectAccountLink GetConnectAccountLinkGetConnectAccountLinkGetConnectAccountLinkResponse `json:"getConnectAccountLink"`
+}
 
-	IconV2 *AppTileModuleIconV2MarketplaceModuleImage `json:"iconV2"`
+// GetGetConnectAccountLink returns GetConnectAccountLinkResponse.GetConnectAccountLink, and is useful for accessing the field via an interface.
+func (v *GetConnectAccountLinkResponse) GetGetConnectAccountLink() GetConnectAccountLinkGetConnectAccountLinkGetConnectAccountLinkResponse {
+	return v.GetConnectAccountLink
 }
 
-func (v *GetPublishedModuleMyModuleMarketplaceModule) MarshalJSON() ([]byte, error) {
-	premarshaled, err := v.__premarshalJSON()
-	if err != nil {
-		return nil, err
-	}
-	return json.Marshal(premarshaled)
+// GetConnectAccountResponse is returned by GetConnectAccount on success.
+type GetConnectAccountResponse struct {
+	ConnectAccount *GetConnectAccountConnectAccount `json:"connectAccount"`
 }
 
-func (v *GetPublishedModuleMyModuleMarketplaceModule) __premarshalJSON() (*__premarshalGetPublishedModuleMyModuleMarketplaceModule, error) {
-	var retval __premarshalGetPublishedModuleMyModuleMarketplaceModule
+// GetConnectAccount returns GetConnectAccountResponse.ConnectAccount, and is useful for accessing the field via an interface.
+func (v *GetConnectAccountResponse) GetConnectAccount() *GetConnectAccountConnectAccount {
+	return v.ConnectAccount
+}
 
-	retval.Title = v.AppTileModule.Title
-	retval.Description = v.AppTileModule.Description
-	retval.Version = v.AppTileModule.Version
-	{
+// GetDraftModuleDraftModuleDraftMarketplaceModule includes the requested fields of the GraphQL type DraftMarketplaceModule.
+type GetDraftModuleDraftModuleDraftMarketplaceModule struct {
+	Title          string `json:"title"`
+	Description    string `json:"description"`
+	ParentModuleId string `json:"parentModuleId"`
+}
 
-		dst := &retval.Source
-		src := v.AppTileModule.Source
-		var err error
-		*dst, err = __marshalAppTileModuleSourceMarketplaceModuleSource(
-			&src)
-		if err != nil {
-			return nil, fmt.Errorf(
-				"Unable to marshal GetPublishedModuleMyModuleMarketplaceModule.AppTileModule.Source: %w", err)
-		}
-	}
-	retval.IconV2 = v.AppTileModule.IconV2
-	return &retval, nil
+// GetTitle returns GetDraftModuleDraftModuleDraftMarketplaceModule.Title, and is useful for accessing the field via an interface.
+func (v *GetDraftModuleDraftModuleDraftMarketplaceModule) GetTitle() string { return v.Title }
+
+// GetDescription returns GetDraftModuleDraftModuleDraftMarketplaceModule.Description, and is useful for accessing the field via an interface.
+func (v *GetDraftModuleDraftModuleDraftMarketplaceModule) GetDescription() string {
+	return v.Description
 }
 
-// GetPublishedModuleResponse is returned by GetPublishedModule on success.
-type GetPublishedModuleResponse struct {
-	MyModule GetPublishedModuleMyModuleMarketplaceModule `json:"myModule"`
+// GetParentModuleId returns GetDraftModuleDraftModuleDraftMarketplaceModule.ParentModuleId, and is useful for accessing the field via an interface.
+func (v *GetDraftModuleDraftModuleDraftMarketplaceModule) GetParentModuleId() string {
+	return v.ParentModuleId
 }
 
-// GetMyModule returns GetPublishedModuleResponse.MyModule, and is useful for accessing the field via an interface.
-func (v *GetPublishedModuleResponse) GetMyModule() GetPublishedModuleMyModuleMarketplaceModule {
-	return v.MyModule
+// GetDraftModulePreviewImagesDraftModuleDraftMarketplaceModule includes the requested fields of the GraphQL type DraftMarketplaceModule.
+type GetDraftModulePreviewImagesDraftModuleDraftMarketplaceModule struct {
+	PreviewImagesV2 *GetDraftModulePreviewImagesDraftModuleDraftMarketplaceModulePreviewImagesV2MarketplaceModulePreviewImages `json:"previewImagesV2"`
 }
 
-type LicenseDetailsInput struct {
-	Message string `json:"message"`
-	Url     string `json:"url"`
+// GetPreviewImagesV2 returns GetDraftModulePreviewImagesDraftModuleDraftMarketplaceModule.PreviewImagesV2, and is useful for accessing the field via an interface.
+func (v *GetDraftModulePreviewImagesDraftModuleDraftMarketplaceModule) GetPreviewImagesV2() *GetDraftModulePreviewImagesDraftModuleDraftMarketplaceModulePreviewImagesV2MarketplaceModulePreviewImages {
+	return v.PreviewImagesV2
 }
 
-// GetMessage returns LicenseDetailsInput.Message, and is useful for accessing the field via an interface.
-func (v *LicenseDetailsInput) GetMessage() string { return v.Message }
+// GetDraftModulePreviewImagesDraftModuleDraftMarketplaceModulePreviewImagesV2MarketplaceModulePreviewImages includes the requested fields of the GraphQL type MarketplaceModulePreviewImages.
+type GetDraftModulePreviewImagesDraftModuleDraftMarketplaceModulePreviewImagesV2MarketplaceModulePreviewImages struct {
+	Images []GetDraftModulePreviewImagesDraftModuleDraftMarketplaceModulePreviewImagesV2MarketplaceModulePreviewImagesImagesMarketplaceModulePreviewImage `json:"images"`
+}
 
-// GetUrl returns LicenseDetailsInput.Url, and is useful for accessing the field via an interface.
-func (v *LicenseDetailsInput) GetUrl() string { return v.Url }
+// GetImages returns GetDraftModulePreviewImagesDraftModuleDraftMarketplaceModulePreviewImagesV2MarketplaceModulePreviewImages.Images, and is useful for accessing the field via an interface.
+func (v *GetDraftModulePreviewImagesDraftModuleDraftMarketplaceModulePreviewImagesV2MarketplaceModulePreviewImages) GetImages() []GetDraftModulePreviewImagesDraftModuleDraftMarketplaceModulePreviewImagesV2MarketplaceModulePreviewImagesImagesMarketplaceModulePreviewImage {
+	return v.Images
+}
 
-type MarketplaceModuleScope string
+// GetDraftModulePreviewImagesDraftModuleDraftMarketplaceModulePreviewImagesV2MarketplaceModulePreviewImagesImagesMarketplaceModulePreviewImage includes the requested fields of the GraphQL type MarketplaceModulePreviewImage.
+type GetDraftModulePreviewImagesDraftModuleDraftMarketplaceModulePreviewImagesV2MarketplaceModulePreviewImagesImagesMarketplaceModulePreviewImage struct {
+	FileName      string `json:"fileName"`
+	FileExtension string `json:"fileExtension"`
+}
 
-const (
-	MarketplaceModuleScopeLicensed     MarketplaceModuleScope = "LICENSED"
-	MarketplaceModuleScopeOrganization MarketplaceModuleScope = "ORGANIZATION"
-	MarketplaceModuleScopePublic       MarketplaceModuleScope = "PUBLIC"
-)
+// GetFileName returns GetDraftModulePreviewImagesDraftModuleDraftMarketplaceModulePreviewImagesV2MarketplaceModulePreviewImagesImagesMarketplaceModulePreviewImage.FileName, and is useful for accessing the field via an interface.
+func (v *GetDraftModulePreviewImagesDraftModuleDraftMarketplaceModulePreviewImagesV2MarketplaceModulePreviewImagesImagesMarketplaceModulePreviewImage) GetFileName() string {
+	return v.FileName
+}
 
-type ModuleCategory string
+// GetFileExtension returns GetDraftModulePreviewImagesDraftModuleDraftMarketplaceModulePreviewImagesV2MarketplaceModulePreviewImagesImagesMarketplaceModulePreviewImage.FileExtension, and is useful for accessing the field via an interface.
+func (v *GetDraftModulePreviewImagesDraftModuleDraftMarketplaceModulePreviewImagesV2MarketplaceModulePreviewImagesImagesMarketplaceModulePreviewImage) GetFileExtension() string {
+	return v.FileExtension
+}
 
-const (
-	ModuleCategoryAppTile             ModuleCategory = "APP_TILE"
-	ModuleCategoryConsent             ModuleCategory = "CONSENT"
-	ModuleCategoryDomainOntology      ModuleCategory = "DOMAIN_ONTOLOGY"
-	ModuleCategoryInsightsLayout      ModuleCategory = "INSIGHTS_LAYOUT"
-	ModuleCategoryNotebook            ModuleCategory = "NOTEBOOK"
-	ModuleCategoryPatientViewerLayout ModuleCategory = "PATIENT_VIEWER_LAYOUT"
-	ModuleCategoryProcessOntology     ModuleCategory = "PROCESS_ONTOLOGY"
-	ModuleCategoryProgramEnrollment   ModuleCategory = "PROGRAM_ENROLLMENT"
-	ModuleCategoryProgramTemplate     ModuleCategory = "PROGRAM_TEMPLATE"
-	ModuleCategoryReportExtractor     ModuleCategory = "REPORT_EXTRACTOR"
-	ModuleCategorySearchLayout        ModuleCategory = "SEARCH_LAYOUT"
-	ModuleCategorySurvey              ModuleCategory = "SURVEY"
-	ModuleCategoryWellnessOffering    ModuleCategory = "WELLNESS_OFFERING"
-	ModuleCategoryWorkflow            ModuleCategory = "WORKFLOW"
-)
+// GetDraftModulePreviewImagesResponse is returned by GetDraftModulePreviewImages on success.
+type GetDraftModulePreviewImagesResponse struct {
+	DraftModule GetDraftModulePreviewImagesDraftModuleDraftMarketplaceModule `json:"draftModule"`
+}
 
-type ModuleProduct string
+// GetDraftModule returns GetDraftModulePreviewImagesResponse.DraftModule, and is useful for accessing the field via an interface.
+func (v *GetDraftModulePreviewImagesResponse) GetDraftModule() GetDraftModulePreviewImagesDraftModuleDraftMarketplaceModule {
+	return v.DraftModule
+}
 
-const (
-	ModuleProductLifeology         ModuleProduct = "LIFEOLOGY"
-	ModuleProductLifeExtendApp     ModuleProduct = "LIFE_EXTEND_APP"
-	ModuleProductLifeFastingApp    ModuleProduct = "LIFE_FASTING_APP"
-	ModuleProductLifeMobileApps    ModuleProduct = "LIFE_MOBILE_APPS"
-	ModuleProductOcr               ModuleProduct = "OCR"
-	ModuleProductPhc               ModuleProduct = "PHC"
-	ModuleProductPrecisionOutcomes ModuleProduct = "PRECISION_OUTCOMES"
-	ModuleProductPrecisionWellness ModuleProduct = "PRECISION_WELLNESS"
-	ModuleProductSkillspring       ModuleProduct = "SKILLSPRING"
-)
+// GetDraftModuleResponse is returned by GetDraftModule on success.
+type GetDraftModuleResponse struct {
+	DraftModule GetDraftModuleDraftModuleDraftMarketplaceModule `json:"draftModule"`
+}
 
-type ModuleVersionInput struct {
-	ChangeLog string `json:"changeLog"`
-	Version   string `json:"version"`
+// GetDraftModule returns GetDraftModuleResponse.DraftModule, and is useful for accessing the field via an interface.
+func (v *GetDraftModuleResponse) GetDraftModule() GetDraftModuleDraftModuleDraftMarketplaceModule {
+	return v.DraftModule
 }
 
-// GetChangeLog returns ModuleVersionInput.ChangeLog, and is useful for accessing the field via an interface.
-func (v *ModuleVersionInput) GetChangeLog() string { return v.ChangeLog }
+// GetDraftModulesInManualReviewDraftModulesInManualReviewDraftMarketplaceModuleConnection includes the requested fields of the GraphQL type DraftMarketplaceModuleConnection.
+type GetDraftModulesInManualReviewDraftModulesInManualReviewDraftMarketplaceModuleConnection struct {
+	Edges    []GetDraftModulesInManualReviewDraftModulesInManualReviewDraftMarketplaceModuleConnectionEdgesDraftMarketplaceModuleEdge `json:"edges"`
+	PageInfo GetDraftModulesInManualReviewDraftModulesInManualReviewDraftMarketplaceModuleConnectionPageInfo                          `json:"pageInfo"`
+}
 
-// GetVersion returns ModuleVersionInput.Version, and is useful for accessing the field via an interface.
-func (v *ModuleVersionInput) GetVersion() string { return v.Version }
+// GetEdges returns GetDraftModulesInManualReviewDraftModulesInManualReviewDraftMarketplaceModuleConnection.Edges, and is useful for accessing the field via an interface.
+func (v *GetDraftModulesInManualReviewDraftModulesInManualReviewDraftMarketplaceModuleConnection) GetEdges() []GetDraftModulesInManualReviewDraftModulesInManualReviewDraftMarketplaceModuleConnectionEdgesDraftMarketplaceModuleEdge {
+	return v.Edges
+}
 
-type PaymentInterval string
+// GetPageInfo returns GetDraftModulesInManualReviewDraftModulesInManualReviewDraftMarketplaceModuleConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *GetDraftModulesInManualReviewDraftModulesInManualReviewDraftMarketplaceModuleConnection) GetPageInfo() GetDraftModulesInManualReviewDraftModulesInManualReviewDraftMarketplaceModuleConnectionPageInfo {
+	return v.PageInfo
+}
 
-const (
-	PaymentIntervalFree    PaymentInterval = "FREE"
-	PaymentIntervalMonthly PaymentInterval = "MONTHLY"
-	PaymentIntervalOnce    PaymentInterval = "ONCE"
-	PaymentIntervalYearly  PaymentInterval = "YEARLY"
-)
+// GetDraftModulesInManualReviewDraftModulesInManualReviewDraftMarketplaceModuleConnectionEdgesDraftMarketplaceModuleEdge includes the requested fields of the GraphQL type DraftMarketplaceModuleEdge.
+type GetDraftModulesInManualReviewDraftModulesInManualReviewDraftMarketplaceModuleConnectionEdgesDraftMarketplaceModuleEdge struct {
+	Node GetDraftModulesInManualReviewDraftModulesInManualReviewDraftMarketplaceModuleConnectionEdgesDraftMarketplaceModuleEdgeNodeDraftMarketplaceModule `json:"node"`
+}
 
-type PublicAppTileModuleSourceInfo struct {
+// GetNode returns GetDraftModulesInManualReviewDraftModulesInManualReviewDraftMarketplaceModuleConnectionEdgesDraftMarketplaceModuleEdge.Node, and is useful for accessing the field via an interface.
+func (v *GetDraftModulesInManualReviewDraftModulesInManualReviewDraftMarketplaceModuleConnectionEdgesDraftMarketplaceModuleEdge) GetNode() GetDraftModulesInManualReviewDraftModulesInManualReviewDraftMarketplaceModuleConnectionEdgesDraftMarketplaceModuleEdgeNodeDraftMarketplaceModule {
+	return v.Node
+}
+
+// GetDraftModulesInManualReviewDraftModulesInManualReviewDraftMarketplaceModuleConnectionEdgesDraftMarketplaceModuleEdgeNodeDraftMarketplaceModule includes the requested fields of the GraphQL type DraftMarketplaceModule.
+type GetDraftModulesInManualReviewDraftModulesInManualReviewDraftMarketplaceModuleConnectionEdgesDraftMarketplaceModuleEdgeNodeDraftMarketplaceModule struct {
+	ReviewQueueModule `json:"-"`
+}
+
+// GetId returns GetDraftModulesInManualReviewDraftModulesInManualReviewDraftMarketplaceModuleConnectionEdgesDraftMarketplaceModuleEdgeNodeDraftMarketplaceModule.Id, and is useful for accessing the field via an interface.
+func (v *GetDraftModulesInManualReviewDraftModulesInManualReviewDraftMarketplaceModuleConnectionEdgesDraftMarketplaceModuleEdgeNodeDraftMarketplaceModule) GetId() string {
+	return v.ReviewQueueModule.Id
+}
+
+// GetTitle returns GetDraftModulesInManualReviewDraftModulesInManualReviewDraftMarketplaceModuleConnectionEdgesDraftMarketplaceModuleEdgeNodeDraftMarketplaceModule.Title, and is useful for accessing the field via an interface.
+func (v *GetDraftModulesInManualReviewDraftModulesInManualReviewDraftMarketplaceModuleConnectionEdgesDraftMarketplaceModuleEdgeNodeDraftMarketplaceModule) GetTitle() string {
+	return v.ReviewQueueModule.Title
+}
+
+// GetDescription returns GetDraftModulesInManualReviewDraftModulesInManualReviewDraftMarketplaceModuleConnectionEdgesDraftMarketplaceModuleEdgeNodeDraftMarketplaceModule.Description, and is useful for accessing the field via an interface.
+func (v *GetDraftModulesInManualReviewDraftModulesInManualReviewDraftMarketplaceModuleConnectionEdgesDraftMarketplaceModuleEdgeNodeDraftMarketplaceModule) GetDescription() string {
+	return v.ReviewQueueModule.Description
+}
+
+// GetCategory returns GetDraftModulesInManualReviewDraftModulesInManualReviewDraftMarketplaceModuleConnectionEdgesDraftMarketplaceModuleEdgeNodeDraftMarketplaceModule.Category, and is useful for accessing the field via an interface.
+func (v *GetDraftModulesInManualReviewDraftModulesInManualReviewDraftMarketplaceModuleConnectionEdgesDraftMarketplaceModuleEdgeNodeDraftMarketplaceModule) GetCategory() ModuleCategory {
+	return v.ReviewQueueModule.Category
+}
+
+// GetParentModuleId returns GetDraftModulesInManualReviewDraftModulesInManualReviewDraftMarketplaceModuleConnectionEdgesDraftMarketplaceModuleEdgeNodeDraftMarketplaceModule.ParentModuleId, and is useful for accessing the field via an interface.
+func (v *GetDraftModulesInManualReviewDraftModulesInManualReviewDraftMarketplaceModuleConnectionEdgesDraftMarketplaceModuleEdgeNodeDraftMarketplaceModule) GetParentModuleId() string {
+	return v.ReviewQueueModule.ParentModuleId
+}
+
+// GetAssignedReviewer returns GetDraftModulesInManualReviewDraftModulesInManualReviewDraftMarketplaceModuleConnectionEdgesDraftMarketplaceModuleEdgeNodeDraftMarketplaceModule.AssignedReviewer, and is useful for accessing the field via an interface.
+func (v *GetDraftModulesInManualReviewDraftModulesInManualReviewDraftMarketplaceModuleConnectionEdgesDraftMarketplaceModuleEdgeNodeDraftMarketplaceModule) GetAssignedReviewer() string {
+	return v.ReviewQueueModule.AssignedReviewer
+}
+
+// GetOrganization returns GetDraftModulesInManualReviewDraftModulesInManualReviewDraftMarketplaceModuleConnectionEdgesDraftMarketplaceModuleEdgeNodeDraftMarketplaceModule.Organization, and is useful for accessing the field via an interface.
+func (v *GetDraftModulesInManualReviewDraftModulesInManualReviewDraftMarketplaceModuleConnectionEdgesDraftMarketplaceModuleEdgeNodeDraftMarketplaceModule) GetOrganization() ReviewQueueModuleOrganizationOrganizationField {
+	return v.ReviewQueueModule.Organization
+}
+
+func (v *GetDraftModulesInManualReviewDraftModulesInManualReviewDraftMarketplaceModuleConnectionEdgesDraftMarketplaceModuleEdgeNodeDraftMarketplaceModule) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*GetDraftModulesInManualReviewDraftModulesInManualReviewDraftMarketplaceModuleConnectionEdgesDraftMarketplaceModuleEdgeNodeDraftMarketplaceModule
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.GetDraftModulesInManualReviewDraftModulesInManualReviewDraftMarketplaceModuleConnectionEdgesDraftMarketplaceModuleEdgeNodeDraftMarketplaceModule = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.ReviewQueueModule)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalGetDraftModulesInManualReviewDraftModulesInManualReviewDraftMarketplaceModuleConnectionEdgesDraftMarketplaceModuleEdgeNodeDraftMarketplaceModule struct {
 	Id string `json:"id"`
+
+	Title string `json:"title"`
+
+	Description string `json:"description"`
+
+	Category ModuleCategory `json:"category"`
+
+	ParentModuleId string `json:"parentModuleId"`
+
+	AssignedReviewer string `json:"assignedReviewer"`
+
+	Organization ReviewQueueModuleOrganizationOrganizationField `json:"organization"`
 }
 
-// GetId returns PublicAppTileModuleSourceInfo.Id, and is useful for accessing the field via an interface.
-func (v *PublicAppTileModuleSourceInfo) GetId() string { return v.Id }
+func (v *GetDraftModulesInManualReviewDraftModulesInManualReviewDraftMarketplaceModuleConnectionEdgesDraftMarketplaceModuleEdgeNodeDraftMarketplaceModule) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
 
-type PublishDraftModuleInputV2 struct {
-	IsTestModule bool               `json:"isTestModule"`
-	ModuleId     string             `json:"moduleId"`
-	ShowAuthor   bool               `json:"showAuthor"`
-	Version      ModuleVersionInput `json:"version"`
+func (v *GetDraftModulesInManualReviewDraftModulesInManualReviewDraftMarketplaceModuleConnectionEdgesDraftMarketplaceModuleEdgeNodeDraftMarketplaceModule) __premarshalJSON() (*__premarshalGetDraftModulesInManualReviewDraftModulesInManualReviewDraftMarketplaceModuleConnectionEdgesDraftMarketplaceModuleEdgeNodeDraftMarketplaceModule, error) {
+	var retval __premarshalGetDraftModulesInManualReviewDraftModulesInManualReviewDraftMarketplaceModuleConnectionEdgesDraftMarketplaceModuleEdgeNodeDraftMarketplaceModule
+
+	retval.Id = v.ReviewQueueModule.Id
+	retval.Title = v.ReviewQueueModule.Title
+	retval.Description = v.ReviewQueueModule.Description
+	retval.Category = v.ReviewQueueModule.Category
+	retval.ParentModuleId = v.ReviewQueueModule.ParentModuleId
+	retval.AssignedReviewer = v.ReviewQueueModule.AssignedReviewer
+	retval.Organization = v.ReviewQueueModule.Organization
+	return &retval, nil
 }
 
-// GetIsTestModule returns PublishDraftModuleInputV2.IsTestModule, and is useful for accessing the field via an interface.
-func (v *PublishDraftModuleInputV2) GetIsTestModule() bool { return v.IsTestModule }
+// GetDraftModulesInManualReviewDraftModulesInManualReviewDraftMarketplaceModuleConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+type GetDraftModulesInManualReviewDraftModulesInManualReviewDraftMarketplaceModuleConnectionPageInfo struct {
+	EndCursor   string `json:"endCursor"`
+	HasNextPage bool   `json:"hasNextPage"`
+}
 
-// GetModuleId returns PublishDraftModuleInputV2.ModuleId, and is useful for accessing the field via an interface.
-func (v *PublishDraftModuleInputV2) GetModuleId() string { return v.ModuleId }
+// GetEndCursor returns GetDraftModulesInManualReviewDraftModulesInManualReviewDraftMarketplaceModuleConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *GetDraftModulesInManualReviewDraftModulesInManualReviewDraftMarketplaceModuleConnectionPageInfo) GetEndCursor() string {
+	return v.EndCursor
+}
 
-// GetShowAuthor returns PublishDraftModuleInputV2.ShowAuthor, and is useful for accessing the field via an interface.
-func (v *PublishDraftModuleInputV2) GetShowAuthor() bool { return v.ShowAuthor }
+// GetHasNextPage returns GetDraftModulesInManualReviewDraftModulesInManualReviewDraftMarketplaceModuleConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *GetDraftModulesInManualReviewDraftModulesInManualReviewDraftMarketplaceModuleConnectionPageInfo) GetHasNextPage() bool {
+	return v.HasNextPage
+}
 
-// GetVersion returns PublishDraftModuleInputV2.Version, and is useful for accessing the field via an interface.
-func (v *PublishDraftModuleInputV2) GetVersion() ModuleVersionInput { return v.Version }
+// GetDraftModulesInManualReviewResponse is returned by GetDraftModulesInManualReview on success.
+type GetDraftModulesInManualReviewResponse struct {
+	DraftModulesInManualReview GetDraftModulesInManualReviewDraftModulesInManualReviewDraftMarketplaceModuleConnection `json:"draftModulesInManualReview"`
+}
 
-// PublishModulePublishDraftModuleV2PublishDraftModuleResponseV2 includes the requested fields of the GraphQL type PublishDraftModuleResponseV2.
-type PublishModulePublishDraftModuleV2PublishDraftModuleResponseV2 struct {
-	Id      string                                                                                    `json:"id"`
-	Version PublishModulePublishDraftModuleV2PublishDraftModuleResponseV2VersionModuleVersionResponse `json:"version"`
+// GetDraftModulesInManualReview returns GetDraftModulesInManualReviewResponse.DraftModulesInManualReview, and is useful for accessing the field via an interface.
+func (v *GetDraftModulesInManualReviewResponse) GetDraftModulesInManualReview() GetDraftModulesInManualReviewDraftModulesInManualReviewDraftMarketplaceModuleConnection {
+	return v.DraftModulesInManualReview
 }
 
-// GetId returns PublishModulePublishDraftModuleV2PublishDraftModuleResponseV2.Id, and is useful for accessing the field via an interface.
-func (v *PublishModulePublishDraftModuleV2PublishDraftModuleResponseV2) GetId() string { return v.Id }
+// GetModuleCategoryModuleMarketplaceModule includes the requested fields of the GraphQL type MarketplaceModule.
+type GetModuleCategoryModuleMarketplaceModule struct {
+	Id       string         `json:"id"`
+	Category ModuleCategory `json:"category"`
+}
 
-// GetVersion returns PublishModulePublishDraftModuleV2PublishDraftModuleResponseV2.Version, and is useful for accessing the field via an interface.
-func (v *PublishModulePublishDraftModuleV2PublishDraftModuleResponseV2) GetVersion() PublishModulePublishDraftModuleV2PublishDraftModuleResponseV2VersionModuleVersionResponse {
-	return v.Version
+// GetId returns GetModuleCategoryModuleMarketplaceModule.Id, and is useful for accessing the field via an interface.
+func (v *GetModuleCategoryModuleMarketplaceModule) GetId() string { return v.Id }
+
+// GetCategory returns GetModuleCategoryModuleMarketplaceModule.Category, and is useful for accessing the field via an interface.
+func (v *GetModuleCategoryModuleMarketplaceModule) GetCategory() ModuleCategory { return v.Category }
+
+// GetModuleCategoryResponse is returned by GetModuleCategory on success.
+type GetModuleCategoryResponse struct {
+	Module GetModuleCategoryModuleMarketplaceModule `json:"module"`
 }
 
-// PublishModulePublishDraftModuleV2PublishDraftModuleResponseV2VersionModuleVersionResponse includes the requested fields of the GraphQL type ModuleVersionResponse.
-type PublishModulePublishDraftModuleV2PublishDraftModuleResponseV2VersionModuleVersionResponse struct {
-	Version string `json:"version"`
+// GetModule returns GetModuleCategoryResponse.Module, and is useful for accessing the field via an interface.
+func (v *GetModuleCategoryResponse) GetModule() GetModuleCategoryModuleMarketplaceModule {
+	return v.Module
 }
 
-// GetVersion returns PublishModulePublishDraftModuleV2PublishDraftModuleResponseV2VersionModuleVersionResponse.Version, and is useful for accessing the field via an interface.
-func (v *PublishModulePublishDraftModuleV2PublishDraftModuleResponseV2VersionModuleVersionResponse) GetVersion() string {
-	return v.Version
+// GetModulePublishReviewModulePublishReview includes the requested fields of the GraphQL type ModulePublishReview.
+type GetModulePublishReviewModulePublishReview struct {
+	PublishReviewFields `json:"-"`
 }
 
-// PublishModuleResponse is returned by PublishModule on success.
-type PublishModuleResponse struct {
-	PublishDraftModuleV2 PublishModulePublishDraftModuleV2PublishDraftModuleResponseV2 `json:"publishDraftModuleV2"`
+// GetId returns GetModulePublishReviewModulePublishReview.Id, and is useful for accessing the field via an interface.
+func (v *GetModulePublishReviewModulePublishReview) GetId() string { return v.PublishReviewFields.Id }
+
+// GetModuleId returns GetModulePublishReviewModulePublishReview.ModuleId, and is useful for accessing the field via an interface.
+func (v *GetModulePublishReviewModulePublishReview) GetModuleId() string {
+	return v.PublishReviewFields.ModuleId
 }
 
-// GetPublishDraftModuleV2 returns PublishModuleResponse.PublishDraftModuleV2, and is useful for accessing the field via an interface.
-func (v *PublishModuleResponse) GetPublishDraftModuleV2() PublishModulePublishDraftModuleV2PublishDraftModuleResponseV2 {
-	return v.PublishDraftModuleV2
+// GetModuleVersion returns GetModulePublishReviewModulePublishReview.ModuleVersion, and is useful for accessing the field via an interface.
+func (v *GetModulePublishReviewModulePublishReview) GetModuleVersion() string {
+	return v.PublishReviewFields.ModuleVersion
 }
 
-// SetAppTileResponse is returned by SetAppTile on success.
-type SetAppTileResponse struct {
-	SetPublicAppTileDraftModuleSource SetAppTileSetPublicAppTileDraftModuleSourceSetAppTileDraftModuleSourceResponse `json:"setPublicAppTileDraftModuleSource"`
+// GetModuleVersionChangelog returns GetModulePublishReviewModulePublishReview.ModuleVersionChangelog, and is useful for accessing the field via an interface.
+func (v *GetModulePublishReviewModulePublishReview) GetModuleVersionChangelog() string {
+	return v.PublishReviewFields.ModuleVersionChangelog
 }
 
-// GetSetPublicAppTileDraftModuleSource returns SetAppTileResponse.SetPublicAppTileDraftModuleSource, and is useful for accessing the field via an interface.
-func (v *SetAppTileResponse) GetSetPublicAppTileDraftModuleSource() SetAppTileSetPublicAppTileDraftModuleSourceSetAppTileDraftModuleSourceResponse {
-	return v.SetPublicAppTileDraftModuleSource
+// GetNotes returns GetModulePublishReviewModulePublishReview.Notes, and is useful for accessing the field via an interface.
+func (v *GetModulePublishReviewModulePublishReview) GetNotes() string {
+	return v.PublishReviewFields.Notes
 }
 
-// SetAppTileSetPublicAppTileDraftModuleSourceSetAppTileDraftModuleSourceResponse includes the requested fields of the GraphQL type SetAppTileDraftModuleSourceResponse.
-type SetAppTileSetPublicAppTileDraftModuleSourceSetAppTileDraftModuleSourceResponse struct {
+// GetStatus returns GetModulePublishReviewModulePublishReview.Status, and is useful for accessing the field via an interface.
+func (v *GetModulePublishReviewModulePublishReview) GetStatus() ModuleReviewStatus {
+	return v.PublishReviewFields.Status
+}
+
+// GetCreatedBy returns GetModulePublishReviewModulePublishReview.CreatedBy, and is useful for accessing the field via an interface.
+func (v *GetModulePublishReviewModulePublishReview) GetCreatedBy() string {
+	return v.PublishReviewFields.CreatedBy
+}
+
+// GetCreated returns GetModulePublishReviewModulePublishReview.Created, and is useful for accessing the field via an interface.
+func (v *GetModulePublishReviewModulePublishReview) GetCreated() int64 {
+	return v.PublishReviewFields.Created
+}
+
+func (v *GetModulePublishReviewModulePublishReview) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*GetModulePublishReviewModulePublishReview
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.GetModulePublishReviewModulePublishReview = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.PublishReviewFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalGetModulePublishReviewModulePublishReview struct {
+	Id string `json:"id"`
+
 	ModuleId string `json:"moduleId"`
+
+	ModuleVersion string `json:"moduleVersion"`
+
+	ModuleVersionChangelog string `json:"moduleVersionChangelog"`
+
+	Notes string `json:"notes"`
+
+	Status ModuleReviewStatus `json:"status"`
+
+	CreatedBy string `json:"createdBy"`
+
+	Created int64 `json:"created"`
 }
 
-// GetModuleId returns SetAppTileSetPublicAppTileDraftModuleSourceSetAppTileDraftModuleSourceResponse.ModuleId, and is useful for accessing the field via an interface.
-func (v *SetAppTileSetPublicAppTileDraftModuleSourceSetAppTileDraftModuleSourceResponse) GetModuleId() string {
-	return v.ModuleId
+func (v *GetModulePublishReviewModulePublishReview) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
 }
 
-type SetPublicAppTileDraftModuleSourceInput struct {
-	ModuleId   string                        `json:"moduleId"`
-	SourceInfo PublicAppTileModuleSourceInfo `json:"sourceInfo"`
+func (v *GetModulePublishReviewModulePublishReview) __premarshalJSON() (*__premarshalGetModulePublishReviewModulePublishReview, error) {
+	var retval __premarshalGetModulePublishReviewModulePublishReview
+
+	retval.Id = v.PublishReviewFields.Id
+	retval.ModuleId = v.PublishReviewFields.ModuleId
+	retval.ModuleVersion = v.PublishReviewFields.ModuleVersion
+	retval.ModuleVersionChangelog = v.PublishReviewFields.ModuleVersionChangelog
+	retval.Notes = v.PublishReviewFields.Notes
+	retval.Status = v.PublishReviewFields.Status
+	retval.CreatedBy = v.PublishReviewFields.CreatedBy
+	retval.Created = v.PublishReviewFields.Created
+	return &retval, nil
 }
 
-// GetModuleId returns SetPublicAppTileDraftModuleSourceInput.ModuleId, and is useful for accessing the field via an interface.
-func (v *SetPublicAppTileDraftModuleSourceInput) GetModuleId() string { return v.ModuleId }
+// GetModulePublishReviewResponse is returned by GetModulePublishReview on success.
+type GetModulePublishReviewResponse struct {
+	ModulePublishReview GetModulePublishReviewModulePublishReview `json:"modulePublishReview"`
+}
 
-// GetSourceInfo returns SetPublicAppTileDraftModuleSourceInput.SourceInfo, and is useful for accessing the field via an interface.
-func (v *SetPublicAppTileDraftModuleSourceInput) GetSourceInfo() PublicAppTileModuleSourceInfo {
-	return v.SourceInfo
+// GetModulePublishReview returns GetModulePublishReviewResponse.ModulePublishReview, and is useful for accessing the field via an interface.
+func (v *GetModulePublishReviewResponse) GetModulePublishReview() GetModulePublishReviewModulePublishReview {
+	return v.ModulePublishReview
 }
 
-// StartImageUploadResponse is returned by StartImageUpload on success.
-type StartImageUploadResponse struct {
-	StartUpload StartImageUploadStartUploadStartUploadResponse `json:"startUpload"`
+// GetModulePublishReviewsModulePublishReviewsModulePublishReviewsConnection includes the requested fields of the GraphQL type ModulePublishReviewsConnection.
+type GetModulePublishReviewsModulePublishReviewsModulePublishReviewsConnection struct {
+	Edges    []GetModulePublishReviewsModulePublishReviewsModulePublishReviewsConnectionEdgesModulePublishReviewsEdge `json:"edges"`
+	PageInfo GetModulePublishReviewsModulePublishReviewsModulePublishReviewsConnectionPageInfo                        `json:"pageInfo"`
 }
 
-// GetStartUpload returns StartImageUploadResponse.StartUpload, and is useful for accessing the field via an interface.
-func (v *StartImageUploadResponse) GetStartUpload() StartImageUploadStartUploadStartUploadResponse {
-	return v.StartUpload
+// GetEdges returns GetModulePublishReviewsModulePublishReviewsModulePublishReviewsConnection.Edges, and is useful for accessing the field via an interface.
+func (v *GetModulePublishReviewsModulePublishReviewsModulePublishReviewsConnection) GetEdges() []GetModulePublishReviewsModulePublishReviewsModulePublishReviewsConnectionEdgesModulePublishReviewsEdge {
+	return v.Edges
 }
 
-// StartImageUploadStartUploadStartUploadResponse includes the requested fields of the GraphQL type StartUploadResponse.
-type StartImageUploadStartUploadStartUploadResponse struct {
-	Id     string            `json:"id"`
-	Url    string            `json:"url"`
-	Fields map[string]string `json:"fields"`
+// GetPageInfo returns GetModulePublishReviewsModulePublishReviewsModulePublishReviewsConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *GetModulePublishReviewsModulePublishReviewsModulePublishReviewsConnection) GetPageInfo() GetModulePublishReviewsModulePublishReviewsModulePublishReviewsConnectionPageInfo {
+	return v.PageInfo
+}
+
+// GetModulePublishReviewsModulePublishReviewsModulePublishReviewsConnectionEdgesModulePublishReviewsEdge includes the requested fields of the GraphQL type ModulePublishReviewsEdge.
+type GetModulePublishReviewsModulePublishReviewsModulePublishReviewsConnectionEdgesModulePublishReviewsEdge struct {
+	Node GetModulePublishReviewsModulePublishReviewsModulePublishReviewsConnectionEdgesModulePublishReviewsEdgeNodeModulePublishReview `json:"node"`
+}
+
+// GetNode returns GetModulePublishReviewsModulePublishReviewsModulePublishReviewsConnectionEdgesModulePublishReviewsEdge.Node, and is useful for accessing the field via an interface.
+func (v *GetModulePublishReviewsModulePublishReviewsModulePublishReviewsConnectionEdgesModulePublishReviewsEdge) GetNode() GetModulePublishReviewsModulePublishReviewsModulePublishReviewsConnectionEdgesModulePublishReviewsEdgeNodeModulePublishReview {
+	return v.Node
+}
+
+// GetModulePublishReviewsModulePublishReviewsModulePublishReviewsConnectionEdgesModulePublishReviewsEdgeNodeModulePublishReview includes the requested fields of the GraphQL type ModulePublishReview.
+type GetModulePublishReviewsModulePublishReviewsModulePublishReviewsConnectionEdgesModulePublishReviewsEdgeNodeModulePublishReview struct {
+	PublishReviewFields `json:"-"`
+}
+
+// GetId returns GetModulePublishReviewsModulePublishReviewsModulePublishReviewsConnectionEdgesModulePublishReviewsEdgeNodeModulePublishReview.Id, and is useful for accessing the field via an interface.
+func (v *GetModulePublishReviewsModulePublishReviewsModulePublishReviewsConnectionEdgesModulePublishReviewsEdgeNodeModulePublishReview) GetId() string {
+	return v.PublishReviewFields.Id
+}
+
+// GetModuleId returns GetModulePublishReviewsModulePublishReviewsModulePublishReviewsConnectionEdgesModulePublishReviewsEdgeNodeModulePublishReview.ModuleId, and is useful for accessing the field via an interface.
+func (v *GetModulePublishReviewsModulePublishReviewsModulePublishReviewsConnectionEdgesModulePublishReviewsEdgeNodeModulePublishReview) GetModuleId() string {
+	return v.PublishReviewFields.ModuleId
+}
+
+// GetModuleVersion returns GetModulePublishReviewsModulePublishReviewsModulePublishReviewsConnectionEdgesModulePublishReviewsEdgeNodeModulePublishReview.ModuleVersion, and is useful for accessing the field via an interface.
+func (v *GetModulePublishReviewsModulePublishReviewsModulePublishReviewsConnectionEdgesModulePublishReviewsEdgeNodeModulePublishReview) GetModuleVersion() string {
+	return v.PublishReviewFields.ModuleVersion
+}
+
+// GetModuleVersionChangelog returns GetModulePublishReviewsModulePublishReviewsModulePublishReviewsConnectionEdgesModulePublishReviewsEdgeNodeModulePublishReview.ModuleVersionChangelog, and is useful for accessing the field via an interface.
+func (v *GetModulePublishReviewsModulePublishReviewsModulePublishReviewsConnectionEdgesModulePublishReviewsEdgeNodeModulePublishReview) GetModuleVersionChangelog() string {
+	return v.PublishReviewFields.ModuleVersionChangelog
+}
+
+// GetNotes returns GetModulePublishReviewsModulePublishReviewsModulePublishReviewsConnectionEdgesModulePublishReviewsEdgeNodeModulePublishReview.Notes, and is useful for accessing the field via an interface.
+func (v *GetModulePublishReviewsModulePublishReviewsModulePublishReviewsConnectionEdgesModulePublishReviewsEdgeNodeModulePublishReview) GetNotes() string {
+	return v.PublishReviewFields.Notes
+}
+
+// GetStatus returns GetModulePublishReviewsModulePublishReviewsModulePublishReviewsConnectionEdgesModulePublishReviewsEdgeNodeModulePublishReview.Status, and is useful for accessing the field via an interface.
+func (v *GetModulePublishReviewsModulePublishReviewsModulePublishReviewsConnectionEdgesModulePublishReviewsEdgeNodeModulePublishReview) GetStatus() ModuleReviewStatus {
+	return v.PublishReviewFields.Status
+}
+
+// GetCreatedBy returns GetModulePublishReviewsModulePublishReviewsModulePublishReviewsConnectionEdgesModulePublishReviewsEdgeNodeModulePublishReview.CreatedBy, and is useful for accessing the field via an interface.
+func (v *GetModulePublishReviewsModulePublishReviewsModulePublishReviewsConnectionEdgesModulePublishReviewsEdgeNodeModulePublishReview) GetCreatedBy() string {
+	return v.PublishReviewFields.CreatedBy
+}
+
+// GetCreated returns GetModulePublishReviewsModulePublishReviewsModulePublishReviewsConnectionEdgesModulePublishReviewsEdgeNodeModulePublishReview.Created, and is useful for accessing the field via an interface.
+func (v *GetModulePublishReviewsModulePublishReviewsModulePublishReviewsConnectionEdgesModulePublishReviewsEdgeNodeModulePublishReview) GetCreated() int64 {
+	return v.PublishReviewFields.Created
+}
+
+func (v *GetModulePublishReviewsModulePublishReviewsModulePublishReviewsConnectionEdgesModulePublishReviewsEdgeNodeModulePublishReview) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*GetModulePublishReviewsModulePublishReviewsModulePublishReviewsConnectionEdgesModulePublishReviewsEdgeNodeModulePublishReview
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.GetModulePublishReviewsModulePublishReviewsModulePublishReviewsConnectionEdgesModulePublishReviewsEdgeNodeModulePublishReview = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.PublishReviewFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalGetModulePublishReviewsModulePublishReviewsModulePublishReviewsConnectionEdgesModulePublishReviewsEdgeNodeModulePublishReview struct {
+	Id string `json:"id"`
+
+	ModuleId string `json:"moduleId"`
+
+	ModuleVersion string `json:"moduleVersion"`
+
+	ModuleVersionChangelog string `json:"moduleVersionChangelog"`
+
+	Notes string `json:"notes"`
+
+	Status ModuleReviewStatus `json:"status"`
+
+	CreatedBy string `json:"createdBy"`
+
+	Created int64 `json:"created"`
+}
+
+func (v *GetModulePublishReviewsModulePublishReviewsModulePublishReviewsConnectionEdgesModulePublishReviewsEdgeNodeModulePublishReview) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *GetModulePublishReviewsModulePublishReviewsModulePublishReviewsConnectionEdgesModulePublishReviewsEdgeNodeModulePublishReview) __premarshalJSON() (*__premarshalGetModulePublishReviewsModulePublishReviewsModulePublishReviewsConnectionEdgesModulePublishReviewsEdgeNodeModulePublishReview, error) {
+	var retval __premarshalGetModulePublishReviewsModulePublishReviewsModulePublishReviewsConnectionEdgesModulePublishReviewsEdgeNodeModulePublishReview
+
+	retval.Id = v.PublishReviewFields.Id
+	retval.ModuleId = v.PublishReviewFields.ModuleId
+	retval.ModuleVersion = v.PublishReviewFields.ModuleVersion
+	retval.ModuleVersionChangelog = v.PublishReviewFields.ModuleVersionChangelog
+	retval.Notes = v.PublishReviewFields.Notes
+	retval.Status = v.PublishReviewFields.Status
+	retval.CreatedBy = v.PublishReviewFields.CreatedBy
+	retval.Created = v.PublishReviewFields.Created
+	return &retval, nil
+}
+
+// GetModulePublishReviewsModulePublishReviewsModulePublishReviewsConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+type GetModulePublishReviewsModulePublishReviewsModulePublishReviewsConnectionPageInfo struct {
+	EndCursor   string `json:"endCursor"`
+	HasNextPage bool   `json:"hasNextPage"`
+}
+
+// GetEndCursor returns GetModulePublishReviewsModulePublishReviewsModulePublishReviewsConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *GetModulePublishReviewsModulePublishReviewsModulePublishReviewsConnectionPageInfo) GetEndCursor() string {
+	return v.EndCursor
+}
+
+// GetHasNextPage returns GetModulePublishReviewsModulePublishReviewsModulePublishReviewsConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *GetModulePublishReviewsModulePublishReviewsModulePublishReviewsConnectionPageInfo) GetHasNextPage() bool {
+	return v.HasNextPage
+}
+
+// GetModulePublishReviewsResponse is returned by GetModulePublishReviews on success.
+type GetModulePublishReviewsResponse struct {
+	ModulePublishReviews GetModulePublishReviewsModulePublishReviewsModulePublishReviewsConnection `json:"modulePublishReviews"`
+}
+
+// GetModulePublishReviews returns GetModulePublishReviewsResponse.ModulePublishReviews, and is useful for accessing the field via an interface.
+func (v *GetModulePublishReviewsResponse) GetModulePublishReviews() GetModulePublishReviewsModulePublishReviewsModulePublishReviewsConnection {
+	return v.ModulePublishReviews
+}
+
+// GetModuleRatingMyModuleMarketplaceModule includes the requested fields of the GraphQL type MarketplaceModule.
+type GetModuleRatingMyModuleMarketplaceModule struct {
+	Rating GetModuleRatingMyModuleMarketplaceModuleRatingEntityRating `json:"rating"`
+}
+
+// GetRating returns GetModuleRatingMyModuleMarketplaceModule.Rating, and is useful for accessing the field via an interface.
+func (v *GetModuleRatingMyModuleMarketplaceModule) GetRating() GetModuleRatingMyModuleMarketplaceModuleRatingEntityRating {
+	return v.Rating
+}
+
+// GetModuleRatingMyModuleMarketplaceModuleRatingEntityRating includes the requested fields of the GraphQL type EntityRating.
+type GetModuleRatingMyModuleMarketplaceModuleRatingEntityRating struct {
+	Average *float64 `json:"average"`
+	Count   int      `json:"count"`
+}
+
+// GetAverage returns GetModuleRatingMyModuleMarketplaceModuleRatingEntityRating.Average, and is useful for accessing the field via an interface.
+func (v *GetModuleRatingMyModuleMarketplaceModuleRatingEntityRating) GetAverage() *float64 {
+	return v.Average
+}
+
+// GetCount returns GetModuleRatingMyModuleMarketplaceModuleRatingEntityRating.Count, and is useful for accessing the field via an interface.
+func (v *GetModuleRatingMyModuleMarketplaceModuleRatingEntityRating) GetCount() int { return v.Count }
+
+// GetModuleRatingResponse is returned by GetModuleRating on success.
+type GetModuleRatingResponse struct {
+	MyModule GetModuleRatingMyModuleMarketplaceModule `json:"myModule"`
+}
+
+// GetMyModule returns GetModuleRatingResponse.MyModule, and is useful for accessing the field via an interface.
+func (v *GetModuleRatingResponse) GetMyModule() GetModuleRatingMyModuleMarketplaceModule {
+	return v.MyModule
+}
+
+// GetModuleReviewsMyModuleMarketplaceModule includes the requested fields of the GraphQL type MarketplaceModule.
+type GetModuleReviewsMyModuleMarketplaceModule struct {
+	Reviews GetModuleReviewsMyModuleMarketplaceModuleReviewsReviewConnection `json:"reviews"`
+}
+
+// GetReviews returns GetModuleReviewsMyModuleMarketplaceModule.Reviews, and is useful for accessing the field via an interface.
+func (v *GetModuleReviewsMyModuleMarketplaceModule) GetReviews() GetModuleReviewsMyModuleMarketplaceModuleReviewsReviewConnection {
+	return v.Reviews
+}
+
+// GetModuleReviewsMyModuleMarketplaceModuleReviewsReviewConnection includes the requested fields of the GraphQL type ReviewConnection.
+type GetModuleReviewsMyModuleMarketplaceModuleReviewsReviewConnection struct {
+	Edges    []GetModuleReviewsMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdge `json:"edges"`
+	PageInfo GetModuleReviewsMyModuleMarketplaceModuleReviewsReviewConnectionPageInfo          `json:"pageInfo"`
+}
+
+// GetEdges returns GetModuleReviewsMyModuleMarketplaceModuleReviewsReviewConnection.Edges, and is useful for accessing the field via an interface.
+func (v *GetModuleReviewsMyModuleMarketplaceModuleReviewsReviewConnection) GetEdges() []GetModuleReviewsMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdge {
+	return v.Edges
+}
+
+// GetPageInfo returns GetModuleReviewsMyModuleMarketplaceModuleReviewsReviewConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *GetModuleReviewsMyModuleMarketplaceModuleReviewsReviewConnection) GetPageInfo() GetModuleReviewsMyModuleMarketplaceModuleReviewsReviewConnectionPageInfo {
+	return v.PageInfo
+}
+
+// GetModuleReviewsMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdge includes the requested fields of the GraphQL type ReviewEdge.
+type GetModuleReviewsMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdge struct {
+	Node GetModuleReviewsMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReview `json:"node"`
+}
+
+// GetNode returns GetModuleReviewsMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdge.Node, and is useful for accessing the field via an interface.
+func (v *GetModuleReviewsMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdge) GetNode() GetModuleReviewsMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReview {
+	return v.Node
+}
+
+// GetModuleReviewsMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReview includes the requested fields of the GraphQL type Review.
+type GetModuleReviewsMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReview struct {
+	ReviewFields `json:"-"`
+}
+
+// GetId returns GetModuleReviewsMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReview.Id, and is useful for accessing the field via an interface.
+func (v *GetModuleReviewsMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReview) GetId() string {
+	return v.ReviewFields.Id
+}
+
+// GetRating returns GetModuleReviewsMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReview.Rating, and is useful for accessing the field via an interface.
+func (v *GetModuleReviewsMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReview) GetRating() int {
+	return v.ReviewFields.Rating
+}
+
+// GetDisplayName returns GetModuleReviewsMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReview.DisplayName, and is useful for accessing the field via an interface.
+func (v *GetModuleReviewsMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReview) GetDisplayName() string {
+	return v.ReviewFields.DisplayName
+}
+
+// GetEntityVersion returns GetModuleReviewsMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReview.EntityVersion, and is useful for accessing the field via an interface.
+func (v *GetModuleReviewsMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReview) GetEntityVersion() string {
+	return v.ReviewFields.EntityVersion
+}
+
+// GetCreatedAt returns GetModuleReviewsMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReview.CreatedAt, and is useful for accessing the field via an interface.
+func (v *GetModuleReviewsMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReview) GetCreatedAt() string {
+	return v.ReviewFields.CreatedAt
+}
+
+// GetUserId returns GetModuleReviewsMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReview.UserId, and is useful for accessing the field via an interface.
+func (v *GetModuleReviewsMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReview) GetUserId() string {
+	return v.ReviewFields.UserId
+}
+
+// GetComment returns GetModuleReviewsMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReview.Comment, and is useful for accessing the field via an interface.
+func (v *GetModuleReviewsMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReview) GetComment() ReviewFieldsCommentPost {
+	return v.ReviewFields.Comment
+}
+
+func (v *GetModuleReviewsMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReview) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*GetModuleReviewsMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReview
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.GetModuleReviewsMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReview = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.ReviewFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalGetModuleReviewsMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReview struct {
+	Id string `json:"id"`
+
+	Rating int `json:"rating"`
+
+	DisplayName string `json:"displayName"`
+
+	EntityVersion string `json:"entityVersion"`
+
+	CreatedAt string `json:"createdAt"`
+
+	UserId string `json:"userId"`
+
+	Comment json.RawMessage `json:"comment"`
+}
+
+func (v *GetModuleReviewsMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReview) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *GetModuleReviewsMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReview) __premarshalJSON() (*__premarshalGetModuleReviewsMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReview, error) {
+	var retval __premarshalGetModuleReviewsMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReview
+
+	retval.Id = v.ReviewFields.Id
+	retval.Rating = v.ReviewFields.Rating
+	retval.DisplayName = v.ReviewFields.DisplayName
+	retval.EntityVersion = v.ReviewFields.EntityVersion
+	retval.CreatedAt = v.ReviewFields.CreatedAt
+	retval.UserId = v.ReviewFields.UserId
+	{
+
+		dst := &retval.Comment
+		src := v.ReviewFields.Comment
+		var err error
+		*dst, err = __marshalReviewFieldsCommentPost(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"Unable to marshal GetModuleReviewsMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReview.ReviewFields.Comment: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+// GetModuleReviewsMyModuleMarketplaceModuleReviewsReviewConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+type GetModuleReviewsMyModuleMarketplaceModuleReviewsReviewConnectionPageInfo struct {
+	EndCursor   string `json:"endCursor"`
+	HasNextPage bool   `json:"hasNextPage"`
+}
+
+// GetEndCursor returns GetModuleReviewsMyModuleMarketplaceModuleReviewsReviewConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *GetModuleReviewsMyModuleMarketplaceModuleReviewsReviewConnectionPageInfo) GetEndCursor() string {
+	return v.EndCursor
+}
+
+// GetHasNextPage returns GetModuleReviewsMyModuleMarketplaceModuleReviewsReviewConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *GetModuleReviewsMyModuleMarketplaceModuleReviewsReviewConnectionPageInfo) GetHasNextPage() bool {
+	return v.HasNextPage
+}
+
+// GetModuleReviewsResponse is returned by GetModuleReviews on success.
+type GetModuleReviewsResponse struct {
+	MyModule GetModuleReviewsMyModuleMarketplaceModule `json:"myModule"`
+}
+
+// GetMyModule returns GetModuleReviewsResponse.MyModule, and is useful for accessing the field via an interface.
+func (v *GetModuleReviewsResponse) GetMyModule() GetModuleReviewsMyModuleMarketplaceModule {
+	return v.MyModule
+}
+
+// GetModuleStatsModuleStatsModuleStatsResponse includes the requested fields of the GraphQL type ModuleStatsResponse.
+type GetModuleStatsModuleStatsModuleStatsResponse struct {
+	Total      int                                                                         `json:"total"`
+	Categories []GetModuleStatsModuleStatsModuleStatsResponseCategoriesModuleCategoryStats `json:"categories"`
+	Scopes     []GetModuleStatsModuleStatsModuleStatsResponseScopesModuleScopeStats        `json:"scopes"`
+	DateRange  GetModuleStatsModuleStatsModuleStatsResponseDateRangeModuleStatsDateRange   `json:"dateRange"`
+}
+
+// GetTotal returns GetModuleStatsModuleStatsModuleStatsResponse.Total, and is useful for accessing the field via an interface.
+func (v *GetModuleStatsModuleStatsModuleStatsResponse) GetTotal() int { return v.Total }
+
+// GetCategories returns GetModuleStatsModuleStatsModuleStatsResponse.Categories, and is useful for accessing the field via an interface.
+func (v *GetModuleStatsModuleStatsModuleStatsResponse) GetCategories() []GetModuleStatsModuleStatsModuleStatsResponseCategoriesModuleCategoryStats {
+	return v.Categories
+}
+
+// GetScopes returns GetModuleStatsModuleStatsModuleStatsResponse.Scopes, and is useful for accessing the field via an interface.
+func (v *GetModuleStatsModuleStatsModuleStatsResponse) GetScopes() []GetModuleStatsModuleStatsModuleStatsResponseScopesModuleScopeStats {
+	return v.Scopes
+}
+
+// GetDateRange returns GetModuleStatsModuleStatsModuleStatsResponse.DateRange, and is useful for accessing the field via an interface.
+func (v *GetModuleStatsModuleStatsModuleStatsResponse) GetDateRange() GetModuleStatsModuleStatsModuleStatsResponseDateRangeModuleStatsDateRange {
+	return v.DateRange
+}
+
+// GetModuleStatsModuleStatsModuleStatsResponseCategoriesModuleCategoryStats includes the requested fields of the GraphQL type ModuleCategoryStats.
+type GetModuleStatsModuleStatsModuleStatsResponseCategoriesModuleCategoryStats struct {
+	Category ModuleCategory `json:"category"`
+	Total    int            `json:"total"`
+}
+
+// GetCategory returns GetModuleStatsModuleStatsModuleStatsResponseCategoriesModuleCategoryStats.Category, and is useful for accessing the field via an interface.
+func (v *GetModuleStatsModuleStatsModuleStatsResponseCategoriesModuleCategoryStats) GetCategory() ModuleCategory {
+	return v.Category
+}
+
+// GetTotal returns GetModuleStatsModuleStatsModuleStatsResponseCategoriesModuleCategoryStats.Total, and is useful for accessing the field via an interface.
+func (v *GetModuleStatsModuleStatsModuleStatsResponseCategoriesModuleCategoryStats) GetTotal() int {
+	return v.Total
+}
+
+// GetModuleStatsModuleStatsModuleStatsResponseDateRangeModuleStatsDateRange includes the requested fields of the GraphQL type ModuleStatsDateRange.
+type GetModuleStatsModuleStatsModuleStatsResponseDateRangeModuleStatsDateRange struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+// GetFrom returns GetModuleStatsModuleStatsModuleStatsResponseDateRangeModuleStatsDateRange.From, and is useful for accessing the field via an interface.
+func (v *GetModuleStatsModuleStatsModuleStatsResponseDateRangeModuleStatsDateRange) GetFrom() string {
+	return v.From
+}
+
+// GetTo returns GetModuleStatsModuleStatsModuleStatsResponseDateRangeModuleStatsDateRange.To, and is useful for accessing the field via an interface.
+func (v *GetModuleStatsModuleStatsModuleStatsResponseDateRangeModuleStatsDateRange) GetTo() string {
+	return v.To
+}
+
+// GetModuleStatsModuleStatsModuleStatsResponseScopesModuleScopeStats includes the requested fields of the GraphQL type ModuleScopeStats.
+type GetModuleStatsModuleStatsModuleStatsResponseScopesModuleScopeStats struct {
+	Scope MarketplaceModuleScope `json:"scope"`
+	Total int                    `json:"total"`
+}
+
+// GetScope returns GetModuleStatsModuleStatsModuleStatsResponseScopesModuleScopeStats.Scope, and is useful for accessing the field via an interface.
+func (v *GetModuleStatsModuleStatsModuleStatsResponseScopesModuleScopeStats) GetScope() MarketplaceModuleScope {
+	return v.Scope
+}
+
+// GetTotal returns GetModuleStatsModuleStatsModuleStatsResponseScopesModuleScopeStats.Total, and is useful for accessing the field via an interface.
+func (v *GetModuleStatsModuleStatsModuleStatsResponseScopesModuleScopeStats) GetTotal() int {
+	return v.Total
+}
+
+// GetModuleStatsResponse is returned by GetModuleStats on success.
+type GetModuleStatsResponse struct {
+	ModuleStats GetModuleStatsModuleStatsModuleStatsResponse `json:"moduleStats"`
+}
+
+// GetModuleStats returns GetModuleStatsResponse.ModuleStats, and is useful for accessing the field via an interface.
+func (v *GetModuleStatsResponse) GetModuleStats() GetModuleStatsModuleStatsModuleStatsResponse {
+	return v.ModuleStats
+}
+
+// GetModuleTagsModuleTagsMarketplaceModuleTagsConnection includes the requested fields of the GraphQL type MarketplaceModuleTagsConnection.
+type GetModuleTagsModuleTagsMarketplaceModuleTagsConnection struct {
+	ModuleTagPage `json:"-"`
+}
+
+// GetEdges returns GetModuleTagsModuleTagsMarketplaceModuleTagsConnection.Edges, and is useful for accessing the field via an interface.
+func (v *GetModuleTagsModuleTagsMarketplaceModuleTagsConnection) GetEdges() []ModuleTagPageEdgesMarketplaceModuleTagsEdge {
+	return v.ModuleTagPage.Edges
+}
+
+// GetPageInfo returns GetModuleTagsModuleTagsMarketplaceModuleTagsConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *GetModuleTagsModuleTagsMarketplaceModuleTagsConnection) GetPageInfo() ModuleTagPagePageInfo {
+	return v.ModuleTagPage.PageInfo
+}
+
+func (v *GetModuleTagsModuleTagsMarketplaceModuleTagsConnection) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*GetModuleTagsModuleTagsMarketplaceModuleTagsConnection
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.GetModuleTagsModuleTagsMarketplaceModuleTagsConnection = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.ModuleTagPage)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalGetModuleTagsModuleTagsMarketplaceModuleTagsConnection struct {
+	Edges []ModuleTagPageEdgesMarketplaceModuleTagsEdge `json:"edges"`
+
+	PageInfo ModuleTagPagePageInfo `json:"pageInfo"`
+}
+
+func (v *GetModuleTagsModuleTagsMarketplaceModuleTagsConnection) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *GetModuleTagsModuleTagsMarketplaceModuleTagsConnection) __premarshalJSON() (*__premarshalGetModuleTagsModuleTagsMarketplaceModuleTagsConnection, error) {
+	var retval __premarshalGetModuleTagsModuleTagsMarketplaceModuleTagsConnection
+
+	retval.Edges = v.ModuleTagPage.Edges
+	retval.PageInfo = v.ModuleTagPage.PageInfo
+	return &retval, nil
+}
+
+// GetModuleTagsResponse is returned by GetModuleTags on success.
+type GetModuleTagsResponse struct {
+	ModuleTags GetModuleTagsModuleTagsMarketplaceModuleTagsConnection `json:"moduleTags"`
+}
+
+// GetModuleTags returns GetModuleTagsResponse.ModuleTags, and is useful for accessing the field via an interface.
+func (v *GetModuleTagsResponse) GetModuleTags() GetModuleTagsModuleTagsMarketplaceModuleTagsConnection {
+	return v.ModuleTags
+}
+
+// GetModuleVersionsMyModuleMarketplaceModule includes the requested fields of the GraphQL type MarketplaceModule.
+type GetModuleVersionsMyModuleMarketplaceModule struct {
+	VersionsV2 GetModuleVersionsMyModuleMarketplaceModuleVersionsV2VersionsV2Connection `json:"versionsV2"`
+}
+
+// GetVersionsV2 returns GetModuleVersionsMyModuleMarketplaceModule.VersionsV2, and is useful for accessing the field via an interface.
+func (v *GetModuleVersionsMyModuleMarketplaceModule) GetVersionsV2() GetModuleVersionsMyModuleMarketplaceModuleVersionsV2VersionsV2Connection {
+	return v.VersionsV2
+}
+
+// GetModuleVersionsMyModuleMarketplaceModuleVersionsV2VersionsV2Connection includes the requested fields of the GraphQL type VersionsV2Connection.
+type GetModuleVersionsMyModuleMarketplaceModuleVersionsV2VersionsV2Connection struct {
+	Edges    []GetModuleVersionsMyModuleMarketplaceModuleVersionsV2VersionsV2ConnectionEdgesVersionsV2Edge `json:"edges"`
+	PageInfo GetModuleVersionsMyModuleMarketplaceModuleVersionsV2VersionsV2ConnectionPageInfo              `json:"pageInfo"`
+}
+
+// GetEdges returns GetModuleVersionsMyModuleMarketplaceModuleVersionsV2VersionsV2Connection.Edges, and is useful for accessing the field via an interface.
+func (v *GetModuleVersionsMyModuleMarketplaceModuleVersionsV2VersionsV2Connection) GetEdges() []GetModuleVersionsMyModuleMarketplaceModuleVersionsV2VersionsV2ConnectionEdgesVersionsV2Edge {
+	return v.Edges
+}
+
+// GetPageInfo returns GetModuleVersionsMyModuleMarketplaceModuleVersionsV2VersionsV2Connection.PageInfo, and is useful for accessing the field via an interface.
+func (v *GetModuleVersionsMyModuleMarketplaceModuleVersionsV2VersionsV2Connection) GetPageInfo() GetModuleVersionsMyModuleMarketplaceModuleVersionsV2VersionsV2ConnectionPageInfo {
+	return v.PageInfo
+}
+
+// GetModuleVersionsMyModuleMarketplaceModuleVersionsV2VersionsV2ConnectionEdgesVersionsV2Edge includes the requested fields of the GraphQL type VersionsV2Edge.
+type GetModuleVersionsMyModuleMarketplaceModuleVersionsV2VersionsV2ConnectionEdgesVersionsV2Edge struct {
+	Node GetModuleVersionsMyModuleMarketplaceModuleVersionsV2VersionsV2ConnectionEdgesVersionsV2EdgeNodeVersionsV2Node `json:"node"`
+}
+
+// GetNode returns GetModuleVersionsMyModuleMarketplaceModuleVersionsV2VersionsV2ConnectionEdgesVersionsV2Edge.Node, and is useful for accessing the field via an interface.
+func (v *GetModuleVersionsMyModuleMarketplaceModuleVersionsV2VersionsV2ConnectionEdgesVersionsV2Edge) GetNode() GetModuleVersionsMyModuleMarketplaceModuleVersionsV2VersionsV2ConnectionEdgesVersionsV2EdgeNodeVersionsV2Node {
+	return v.Node
+}
+
+// GetModuleVersionsMyModuleMarketplaceModuleVersionsV2VersionsV2ConnectionEdgesVersionsV2EdgeNodeVersionsV2Node includes the requested fields of the GraphQL type VersionsV2Node.
+type GetModuleVersionsMyModuleMarketplaceModuleVersionsV2VersionsV2ConnectionEdgesVersionsV2EdgeNodeVersionsV2Node struct {
+	ModuleVersionFields `json:"-"`
+}
+
+// GetVersion returns GetModuleVersionsMyModuleMarketplaceModuleVersionsV2VersionsV2ConnectionEdgesVersionsV2EdgeNodeVersionsV2Node.Version, and is useful for accessing the field via an interface.
+func (v *GetModuleVersionsMyModuleMarketplaceModuleVersionsV2VersionsV2ConnectionEdgesVersionsV2EdgeNodeVersionsV2Node) GetVersion() string {
+	return v.ModuleVersionFields.Version
+}
+
+// GetCreated returns GetModuleVersionsMyModuleMarketplaceModuleVersionsV2VersionsV2ConnectionEdgesVersionsV2EdgeNodeVersionsV2Node.Created, and is useful for accessing the field via an interface.
+func (v *GetModuleVersionsMyModuleMarketplaceModuleVersionsV2VersionsV2ConnectionEdgesVersionsV2EdgeNodeVersionsV2Node) GetCreated() int64 {
+	return v.ModuleVersionFields.Created
+}
+
+// GetChangeLog returns GetModuleVersionsMyModuleMarketplaceModuleVersionsV2VersionsV2ConnectionEdgesVersionsV2EdgeNodeVersionsV2Node.ChangeLog, and is useful for accessing the field via an interface.
+func (v *GetModuleVersionsMyModuleMarketplaceModuleVersionsV2VersionsV2ConnectionEdgesVersionsV2EdgeNodeVersionsV2Node) GetChangeLog() string {
+	return v.ModuleVersionFields.ChangeLog
+}
+
+func (v *GetModuleVersionsMyModuleMarketplaceModuleVersionsV2VersionsV2ConnectionEdgesVersionsV2EdgeNodeVersionsV2Node) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*GetModuleVersionsMyModuleMarketplaceModuleVersionsV2VersionsV2ConnectionEdgesVersionsV2EdgeNodeVersionsV2Node
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.GetModuleVersionsMyModuleMarketplaceModuleVersionsV2VersionsV2ConnectionEdgesVersionsV2EdgeNodeVersionsV2Node = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.ModuleVersionFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalGetModuleVersionsMyModuleMarketplaceModuleVersionsV2VersionsV2ConnectionEdgesVersionsV2EdgeNodeVersionsV2Node struct {
+	Version string `json:"version"`
+
+	Created int64 `json:"created"`
+
+	ChangeLog string `json:"changeLog"`
+}
+
+func (v *GetModuleVersionsMyModuleMarketplaceModuleVersionsV2VersionsV2ConnectionEdgesVersionsV2EdgeNodeVersionsV2Node) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *GetModuleVersionsMyModuleMarketplaceModuleVersionsV2VersionsV2ConnectionEdgesVersionsV2EdgeNodeVersionsV2Node) __premarshalJSON() (*__premarshalGetModuleVersionsMyModuleMarketplaceModuleVersionsV2VersionsV2ConnectionEdgesVersionsV2EdgeNodeVersionsV2Node, error) {
+	var retval __premarshalGetModuleVersionsMyModuleMarketplaceModuleVersionsV2VersionsV2ConnectionEdgesVersionsV2EdgeNodeVersionsV2Node
+
+	retval.Version = v.ModuleVersionFields.Version
+	retval.Created = v.ModuleVersionFields.Created
+	retval.ChangeLog = v.ModuleVersionFields.ChangeLog
+	return &retval, nil
+}
+
+// GetModuleVersionsMyModuleMarketplaceModuleVersionsV2VersionsV2ConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+type GetModuleVersionsMyModuleMarketplaceModuleVersionsV2VersionsV2ConnectionPageInfo struct {
+	EndCursor   string `json:"endCursor"`
+	HasNextPage bool   `json:"hasNextPage"`
+}
+
+// GetEndCursor returns GetModuleVersionsMyModuleMarketplaceModuleVersionsV2VersionsV2ConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *GetModuleVersionsMyModuleMarketplaceModuleVersionsV2VersionsV2ConnectionPageInfo) GetEndCursor() string {
+	return v.EndCursor
+}
+
+// GetHasNextPage returns GetModuleVersionsMyModuleMarketplaceModuleVersionsV2VersionsV2ConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *GetModuleVersionsMyModuleMarketplaceModuleVersionsV2VersionsV2ConnectionPageInfo) GetHasNextPage() bool {
+	return v.HasNextPage
+}
+
+// GetModuleVersionsResponse is returned by GetModuleVersions on success.
+type GetModuleVersionsResponse struct {
+	MyModule GetModuleVersionsMyModuleMarketplaceModule `json:"myModule"`
+}
+
+// GetMyModule returns GetModuleVersionsResponse.MyModule, and is useful for accessing the field via an interface.
+func (v *GetModuleVersionsResponse) GetMyModule() GetModuleVersionsMyModuleMarketplaceModule {
+	return v.MyModule
+}
+
+// GetMyInstallMyInstall includes the requested fields of the GraphQL type Install.
+type GetMyInstallMyInstall struct {
+	InstallFields `json:"-"`
+}
+
+// GetId returns GetMyInstallMyInstall.Id, and is useful for accessing the field via an interface.
+func (v *GetMyInstallMyInstall) GetId() string { return v.InstallFields.Id }
+
+// GetInstalledOn returns GetMyInstallMyInstall.InstalledOn, and is useful for accessing the field via an interface.
+func (v *GetMyInstallMyInstall) GetInstalledOn() int64 { return v.InstallFields.InstalledOn }
+
+// GetModule returns GetMyInstallMyInstall.Module, and is useful for accessing the field via an interface.
+func (v *GetMyInstallMyInstall) GetModule() InstallFieldsModuleInstallModule {
+	return v.InstallFields.Module
+}
+
+func (v *GetMyInstallMyInstall) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*GetMyInstallMyInstall
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.GetMyInstallMyInstall = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.InstallFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalGetMyInstallMyInstall struct {
+	Id string `json:"id"`
+
+	InstalledOn int64 `json:"installedOn"`
+
+	Module json.RawMessage `json:"module"`
+}
+
+func (v *GetMyInstallMyInstall) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *GetMyInstallMyInstall) __premarshalJSON() (*__premarshalGetMyInstallMyInstall, error) {
+	var retval __premarshalGetMyInstallMyInstall
+
+	retval.Id = v.InstallFields.Id
+	retval.InstalledOn = v.InstallFields.InstalledOn
+	{
+
+		dst := &retval.Module
+		src := v.InstallFields.Module
+		var err error
+		*dst, err = __marshalInstallFieldsModuleInstallModule(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"Unable to marshal GetMyInstallMyInstall.InstallFields.Module: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+// GetMyInstallResponse is returned by GetMyInstall on success.
+type GetMyInstallResponse struct {
+	MyInstall GetMyInstallMyInstall `json:"myInstall"`
+}
+
+// GetMyInstall returns GetMyInstallResponse.MyInstall, and is useful for accessing the field via an interface.
+func (v *GetMyInstallResponse) GetMyInstall() GetMyInstallMyInstall { return v.MyInstall }
+
+// GetMyInstallsMyInstallsInstallConnection includes the requested fields of the GraphQL type InstallConnection.
+type GetMyInstallsMyInstallsInstallConnection struct {
+	InstallPage `json:"-"`
+}
+
+// GetEdges returns GetMyInstallsMyInstallsInstallConnection.Edges, and is useful for accessing the field via an interface.
+func (v *GetMyInstallsMyInstallsInstallConnection) GetEdges() []InstallPageEdgesInstallEdge {
+	return v.InstallPage.Edges
+}
+
+// GetPageInfo returns GetMyInstallsMyInstallsInstallConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *GetMyInstallsMyInstallsInstallConnection) GetPageInfo() InstallPagePageInfo {
+	return v.InstallPage.PageInfo
+}
+
+func (v *GetMyInstallsMyInstallsInstallConnection) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*GetMyInstallsMyInstallsInstallConnection
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.GetMyInstallsMyInstallsInstallConnection = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.InstallPage)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalGetMyInstallsMyInstallsInstallConnection struct {
+	Edges []InstallPageEdgesInstallEdge `json:"edges"`
+
+	PageInfo InstallPagePageInfo `json:"pageInfo"`
+}
+
+func (v *GetMyInstallsMyInstallsInstallConnection) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *GetMyInstallsMyInstallsInstallConnection) __premarshalJSON() (*__premarshalGetMyInstallsMyInstallsInstallConnection, error) {
+	var retval __premarshalGetMyInstallsMyInstallsInstallConnection
+
+	retval.Edges = v.InstallPage.Edges
+	retval.PageInfo = v.InstallPage.PageInfo
+	return &retval, nil
+}
+
+// GetMyInstallsResponse is returned by GetMyInstalls on success.
+type GetMyInstallsResponse struct {
+	MyInstalls GetMyInstallsMyInstallsInstallConnection `json:"myInstalls"`
+}
+
+// GetMyInstalls returns GetMyInstallsResponse.MyInstalls, and is useful for accessing the field via an interface.
+func (v *GetMyInstallsResponse) GetMyInstalls() GetMyInstallsMyInstallsInstallConnection {
+	return v.MyInstalls
+}
+
+// GetMyPurchasesMyPurchasesPurchasesConnection includes the requested fields of the GraphQL type PurchasesConnection.
+type GetMyPurchasesMyPurchasesPurchasesConnection struct {
+	Edges    []GetMyPurchasesMyPurchasesPurchasesConnectionEdgesPurchaseEdge `json:"edges"`
+	PageInfo GetMyPurchasesMyPurchasesPurchasesConnectionPageInfo            `json:"pageInfo"`
+}
+
+// GetEdges returns GetMyPurchasesMyPurchasesPurchasesConnection.Edges, and is useful for accessing the field via an interface.
+func (v *GetMyPurchasesMyPurchasesPurchasesConnection) GetEdges() []GetMyPurchasesMyPurchasesPurchasesConnectionEdgesPurchaseEdge {
+	return v.Edges
+}
+
+// GetPageInfo returns GetMyPurchasesMyPurchasesPurchasesConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *GetMyPurchasesMyPurchasesPurchasesConnection) GetPageInfo() GetMyPurchasesMyPurchasesPurchasesConnectionPageInfo {
+	return v.PageInfo
+}
+
+// GetMyPurchasesMyPurchasesPurchasesConnectionEdgesPurchaseEdge includes the requested fields of the GraphQL type PurchaseEdge.
+type GetMyPurchasesMyPurchasesPurchasesConnectionEdgesPurchaseEdge struct {
+	Node GetMyPurchasesMyPurchasesPurchasesConnectionEdgesPurchaseEdgeNodePurchase `json:"node"`
+}
+
+// GetNode returns GetMyPurchasesMyPurchasesPurchasesConnectionEdgesPurchaseEdge.Node, and is useful for accessing the field via an interface.
+func (v *GetMyPurchasesMyPurchasesPurchasesConnectionEdgesPurchaseEdge) GetNode() GetMyPurchasesMyPurchasesPurchasesConnectionEdgesPurchaseEdgeNodePurchase {
+	return v.Node
+}
+
+// GetMyPurchasesMyPurchasesPurchasesConnectionEdgesPurchaseEdgeNodePurchase includes the requested fields of the GraphQL type Purchase.
+type GetMyPurchasesMyPurchasesPurchasesConnectionEdgesPurchaseEdgeNodePurchase struct {
+	PurchaseFields `json:"-"`
+}
+
+// GetPurchaseId returns GetMyPurchasesMyPurchasesPurchasesConnectionEdgesPurchaseEdgeNodePurchase.PurchaseId, and is useful for accessing the field via an interface.
+func (v *GetMyPurchasesMyPurchasesPurchasesConnectionEdgesPurchaseEdgeNodePurchase) GetPurchaseId() string {
+	return v.PurchaseFields.PurchaseId
+}
+
+// GetModuleId returns GetMyPurchasesMyPurchasesPurchasesConnectionEdgesPurchaseEdgeNodePurchase.ModuleId, and is useful for accessing the field via an interface.
+func (v *GetMyPurchasesMyPurchasesPurchasesConnectionEdgesPurchaseEdgeNodePurchase) GetModuleId() string {
+	return v.PurchaseFields.ModuleId
+}
+
+// GetStatus returns GetMyPurchasesMyPurchasesPurchasesConnectionEdgesPurchaseEdgeNodePurchase.Status, and is useful for accessing the field via an interface.
+func (v *GetMyPurchasesMyPurchasesPurchasesConnectionEdgesPurchaseEdgeNodePurchase) GetStatus() PurchaseStatus {
+	return v.PurchaseFields.Status
+}
+
+// GetType returns GetMyPurchasesMyPurchasesPurchasesConnectionEdgesPurchaseEdgeNodePurchase.Type, and is useful for accessing the field via an interface.
+func (v *GetMyPurchasesMyPurchasesPurchasesConnectionEdgesPurchaseEdgeNodePurchase) GetType() PurchaseType {
+	return v.PurchaseFields.Type
+}
+
+// GetPurchasedAt returns GetMyPurchasesMyPurchasesPurchasesConnectionEdgesPurchaseEdgeNodePurchase.PurchasedAt, and is useful for accessing the field via an interface.
+func (v *GetMyPurchasesMyPurchasesPurchasesConnectionEdgesPurchaseEdgeNodePurchase) GetPurchasedAt() int64 {
+	return v.PurchaseFields.PurchasedAt
+}
+
+// GetCancelledAt returns GetMyPurchasesMyPurchasesPurchasesConnectionEdgesPurchaseEdgeNodePurchase.CancelledAt, and is useful for accessing the field via an interface.
+func (v *GetMyPurchasesMyPurchasesPurchasesConnectionEdgesPurchaseEdgeNodePurchase) GetCancelledAt() *int64 {
+	return v.PurchaseFields.CancelledAt
+}
+
+// GetModule returns GetMyPurchasesMyPurchasesPurchasesConnectionEdgesPurchaseEdgeNodePurchase.Module, and is useful for accessing the field via an interface.
+func (v *GetMyPurchasesMyPurchasesPurchasesConnectionEdgesPurchaseEdgeNodePurchase) GetModule() PurchaseFieldsModulePurchaseModule {
+	return v.PurchaseFields.Module
+}
+
+func (v *GetMyPurchasesMyPurchasesPurchasesConnectionEdgesPurchaseEdgeNodePurchase) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*GetMyPurchasesMyPurchasesPurchasesConnectionEdgesPurchaseEdgeNodePurchase
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.GetMyPurchasesMyPurchasesPurchasesConnectionEdgesPurchaseEdgeNodePurchase = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.PurchaseFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalGetMyPurchasesMyPurchasesPurchasesConnectionEdgesPurchaseEdgeNodePurchase struct {
+	PurchaseId string `json:"purchaseId"`
+
+	ModuleId string `json:"moduleId"`
+
+	Status PurchaseStatus `json:"status"`
+
+	Type PurchaseType `json:"type"`
+
+	PurchasedAt int64 `json:"purchasedAt"`
+
+	CancelledAt *int64 `json:"cancelledAt"`
+
+	Module json.RawMessage `json:"module"`
+}
+
+func (v *GetMyPurchasesMyPurchasesPurchasesConnectionEdgesPurchaseEdgeNodePurchase) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *GetMyPurchasesMyPurchasesPurchasesConnectionEdgesPurchaseEdgeNodePurchase) __premarshalJSON() (*__premarshalGetMyPurchasesMyPurchasesPurchasesConnectionEdgesPurchaseEdgeNodePurchase, error) {
+	var retval __premarshalGetMyPurchasesMyPurchasesPurchasesConnectionEdgesPurchaseEdgeNodePurchase
+
+	retval.PurchaseId = v.PurchaseFields.PurchaseId
+	retval.ModuleId = v.PurchaseFields.ModuleId
+	retval.Status = v.PurchaseFields.Status
+	retval.Type = v.PurchaseFields.Type
+	retval.PurchasedAt = v.PurchaseFields.PurchasedAt
+	retval.CancelledAt = v.PurchaseFields.CancelledAt
+	{
+
+		dst := &retval.Module
+		src := v.PurchaseFields.Module
+		var err error
+		*dst, err = __marshalPurchaseFieldsModulePurchaseModule(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"Unable to marshal GetMyPurchasesMyPurchasesPurchasesConnectionEdgesPurchaseEdgeNodePurchase.PurchaseFields.Module: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+// GetMyPurchasesMyPurchasesPurchasesConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+type GetMyPurchasesMyPurchasesPurchasesConnectionPageInfo struct {
+	EndCursor   string `json:"endCursor"`
+	HasNextPage bool   `json:"hasNextPage"`
+}
+
+// GetEndCursor returns GetMyPurchasesMyPurchasesPurchasesConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *GetMyPurchasesMyPurchasesPurchasesConnectionPageInfo) GetEndCursor() string {
+	return v.EndCursor
+}
+
+// GetHasNextPage returns GetMyPurchasesMyPurchasesPurchasesConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *GetMyPurchasesMyPurchasesPurchasesConnectionPageInfo) GetHasNextPage() bool {
+	return v.HasNextPage
+}
+
+// GetMyPurchasesResponse is returned by GetMyPurchases on success.
+type GetMyPurchasesResponse struct {
+	// Returns the current active modules that the user has access to
+	//
+	// If provided, `type` filters the results to those of just that type.
+	//
+	// By default, this query returns all purchases. However, specifying `status`
+	// will allow you to filter purchases to just those that are active or inactive.
+	MyPurchases GetMyPurchasesMyPurchasesPurchasesConnection `json:"myPurchases"`
+}
+
+// GetMyPurchases returns GetMyPurchasesResponse.MyPurchases, and is useful for accessing the field via an interface.
+func (v *GetMyPurchasesResponse) GetMyPurchases() GetMyPurchasesMyPurchasesPurchasesConnection {
+	return v.MyPurchases
+}
+
+// GetOrgInstallOrgInstall includes the requested fields of the GraphQL type Install.
+type GetOrgInstallOrgInstall struct {
+	InstallFields `json:"-"`
+}
+
+// GetId returns GetOrgInstallOrgInstall.Id, and is useful for accessing the field via an interface.
+func (v *GetOrgInstallOrgInstall) GetId() string { return v.InstallFields.Id }
+
+// GetInstalledOn returns GetOrgInstallOrgInstall.InstalledOn, and is useful for accessing the field via an interface.
+func (v *GetOrgInstallOrgInstall) GetInstalledOn() int64 { return v.InstallFields.InstalledOn }
+
+// GetModule returns GetOrgInstallOrgInstall.Module, and is useful for accessing the field via an interface.
+func (v *GetOrgInstallOrgInstall) GetModule() InstallFieldsModuleInstallModule {
+	return v.InstallFields.Module
+}
+
+func (v *GetOrgInstallOrgInstall) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*GetOrgInstallOrgInstall
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.GetOrgInstallOrgInstall = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.InstallFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalGetOrgInstallOrgInstall struct {
+	Id string `json:"id"`
+
+	InstalledOn int64 `json:"installedOn"`
+
+	Module json.RawMessage `json:"module"`
+}
+
+func (v *GetOrgInstallOrgInstall) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *GetOrgInstallOrgInstall) __premarshalJSON() (*__premarshalGetOrgInstallOrgInstall, error) {
+	var retval __premarshalGetOrgInstallOrgInstall
+
+	retval.Id = v.InstallFields.Id
+	retval.InstalledOn = v.InstallFields.InstalledOn
+	{
+
+		dst := &retval.Module
+		src := v.InstallFields.Module
+		var err error
+		*dst, err = __marshalInstallFieldsModuleInstallModule(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"Unable to marshal GetOrgInstallOrgInstall.InstallFields.Module: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+// GetOrgInstallResponse is returned by GetOrgInstall on success.
+type GetOrgInstallResponse struct {
+	OrgInstall GetOrgInstallOrgInstall `json:"orgInstall"`
+}
+
+// GetOrgInstall returns GetOrgInstallResponse.OrgInstall, and is useful for accessing the field via an interface.
+func (v *GetOrgInstallResponse) GetOrgInstall() GetOrgInstallOrgInstall { return v.OrgInstall }
+
+// GetOrgInstallsOrgInstallsInstallConnection includes the requested fields of the GraphQL type InstallConnection.
+type GetOrgInstallsOrgInstallsInstallConnection struct {
+	InstallPage `json:"-"`
+}
+
+// GetEdges returns GetOrgInstallsOrgInstallsInstallConnection.Edges, and is useful for accessing the field via an interface.
+func (v *GetOrgInstallsOrgInstallsInstallConnection) GetEdges() []InstallPageEdgesInstallEdge {
+	return v.InstallPage.Edges
+}
+
+// GetPageInfo returns GetOrgInstallsOrgInstallsInstallConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *GetOrgInstallsOrgInstallsInstallConnection) GetPageInfo() InstallPagePageInfo {
+	return v.InstallPage.PageInfo
+}
+
+func (v *GetOrgInstallsOrgInstallsInstallConnection) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*GetOrgInstallsOrgInstallsInstallConnection
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.GetOrgInstallsOrgInstallsInstallConnection = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.InstallPage)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalGetOrgInstallsOrgInstallsInstallConnection struct {
+	Edges []InstallPageEdgesInstallEdge `json:"edges"`
+
+	PageInfo InstallPagePageInfo `json:"pageInfo"`
+}
+
+func (v *GetOrgInstallsOrgInstallsInstallConnection) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *GetOrgInstallsOrgInstallsInstallConnection) __premarshalJSON() (*__premarshalGetOrgInstallsOrgInstallsInstallConnection, error) {
+	var retval __premarshalGetOrgInstallsOrgInstallsInstallConnection
+
+	retval.Edges = v.InstallPage.Edges
+	retval.PageInfo = v.InstallPage.PageInfo
+	return &retval, nil
+}
+
+// GetOrgInstallsResponse is returned by GetOrgInstalls on success.
+type GetOrgInstallsResponse struct {
+	OrgInstalls GetOrgInstallsOrgInstallsInstallConnection `json:"orgInstalls"`
+}
+
+// GetOrgInstalls returns GetOrgInstallsResponse.OrgInstalls, and is useful for accessing the field via an interface.
+func (v *GetOrgInstallsResponse) GetOrgInstalls() GetOrgInstallsOrgInstallsInstallConnection {
+	return v.OrgInstalls
+}
+
+// GetOrgModuleCategoryOrgModuleMarketplaceModule includes the requested fields of the GraphQL type MarketplaceModule.
+type GetOrgModuleCategoryOrgModuleMarketplaceModule struct {
+	Id       string         `json:"id"`
+	Category ModuleCategory `json:"category"`
+}
+
+// GetId returns GetOrgModuleCategoryOrgModuleMarketplaceModule.Id, and is useful for accessing the field via an interface.
+func (v *GetOrgModuleCategoryOrgModuleMarketplaceModule) GetId() string { return v.Id }
+
+// GetCategory returns GetOrgModuleCategoryOrgModuleMarketplaceModule.Category, and is useful for accessing the field via an interface.
+func (v *GetOrgModuleCategoryOrgModuleMarketplaceModule) GetCategory() ModuleCategory {
+	return v.Category
+}
+
+// GetOrgModuleCategoryResponse is returned by GetOrgModuleCategory on success.
+type GetOrgModuleCategoryResponse struct {
+	OrgModule GetOrgModuleCategoryOrgModuleMarketplaceModule `json:"orgModule"`
+}
+
+// GetOrgModule returns GetOrgModuleCategoryResponse.OrgModule, and is useful for accessing the field via an interface.
+func (v *GetOrgModuleCategoryResponse) GetOrgModule() GetOrgModuleCategoryOrgModuleMarketplaceModule {
+	return v.OrgModule
+}
+
+// GetOrgModuleTagsOrgModuleTagsMarketplaceModuleTagsConnection includes the requested fields of the GraphQL type MarketplaceModuleTagsConnection.
+type GetOrgModuleTagsOrgModuleTagsMarketplaceModuleTagsConnection struct {
+	ModuleTagPage `json:"-"`
+}
+
+// GetEdges returns GetOrgModuleTagsOrgModuleTagsMarketplaceModuleTagsConnection.Edges, and is useful for accessing the field via an interface.
+func (v *GetOrgModuleTagsOrgModuleTagsMarketplaceModuleTagsConnection) GetEdges() []ModuleTagPageEdgesMarketplaceModuleTagsEdge {
+	return v.ModuleTagPage.Edges
+}
+
+// GetPageInfo returns GetOrgModuleTagsOrgModuleTagsMarketplaceModuleTagsConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *GetOrgModuleTagsOrgModuleTagsMarketplaceModuleTagsConnection) GetPageInfo() ModuleTagPagePageInfo {
+	return v.ModuleTagPage.PageInfo
+}
+
+func (v *GetOrgModuleTagsOrgModuleTagsMarketplaceModuleTagsConnection) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*GetOrgModuleTagsOrgModuleTagsMarketplaceModuleTagsConnection
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.GetOrgModuleTagsOrgModuleTagsMarketplaceModuleTagsConnection = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.ModuleTagPage)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalGetOrgModuleTagsOrgModuleTagsMarketplaceModuleTagsConnection struct {
+	Edges []ModuleTagPageEdgesMarketplaceModuleTagsEdge `json:"edges"`
+
+	PageInfo ModuleTagPagePageInfo `json:"pageInfo"`
+}
+
+func (v *GetOrgModuleTagsOrgModuleTagsMarketplaceModuleTagsConnection) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *GetOrgModuleTagsOrgModuleTagsMarketplaceModuleTagsConnection) __premarshalJSON() (*__premarshalGetOrgModuleTagsOrgModuleTagsMarketplaceModuleTagsConnection, error) {
+	var retval __premarshalGetOrgModuleTagsOrgModuleTagsMarketplaceModuleTagsConnection
+
+	retval.Edges = v.ModuleTagPage.Edges
+	retval.PageInfo = v.ModuleTagPage.PageInfo
+	return &retval, nil
+}
+
+// GetOrgModuleTagsResponse is returned by GetOrgModuleTags on success.
+type GetOrgModuleTagsResponse struct {
+	OrgModuleTags GetOrgModuleTagsOrgModuleTagsMarketplaceModuleTagsConnection `json:"orgModuleTags"`
+}
+
+// GetOrgModuleTags returns GetOrgModuleTagsResponse.OrgModuleTags, and is useful for accessing the field via an interface.
+func (v *GetOrgModuleTagsResponse) GetOrgModuleTags() GetOrgModuleTagsOrgModuleTagsMarketplaceModuleTagsConnection {
+	return v.OrgModuleTags
+}
+
+// GetPublishedModuleMyModuleMarketplaceModule includes the requested fields of the GraphQL type MarketplaceModule.
+type GetPublishedModuleMyModuleMarketplaceModule struct {
+	AppTileModule `json:"-"`
+}
+
+// GetTitle returns GetPublishedModuleMyModuleMarketplaceModule.Title, and is useful for accessing the field via an interface.
+func (v *GetPublishedModuleMyModuleMarketplaceModule) GetTitle() string { return v.AppTileModule.Title }
+
+// GetDescription returns GetPublishedModuleMyModuleMarketplaceModule.Description, and is useful for accessing the field via an interface.
+func (v *GetPublishedModuleMyModuleMarketplaceModule) GetDescription() string {
+	return v.AppTileModule.Description
+}
+
+// GetVersion returns GetPublishedModuleMyModuleMarketplaceModule.Version, and is useful for accessing the field via an interface.
+func (v *GetPublishedModuleMyModuleMarketplaceModule) GetVersion() string {
+	return v.AppTileModule.Version
+}
+
+// GetTags returns GetPublishedModuleMyModuleMarketplaceModule.Tags, and is useful for accessing the field via an interface.
+func (v *GetPublishedModuleMyModuleMarketplaceModule) GetTags() []string { return v.AppTileModule.Tags }
+
+// GetPrices returns GetPublishedModuleMyModuleMarketplaceModule.Prices, and is useful for accessing the field via an interface.
+func (v *GetPublishedModuleMyModuleMarketplaceModule) GetPrices() []AppTileModulePricesModulePrice {
+	return v.AppTileModule.Prices
+}
+
+// GetSource returns GetPublishedModuleMyModuleMarketplaceModule.Source, and is useful for accessing the field via an interface.
+func (v *GetPublishedModuleMyModuleMarketplaceModule) GetSource() AppTileModuleSourceMarketplaceModuleSource {
+	return v.AppTileModule.Source
+}
+
+// GetIconV2 returns GetPublishedModuleMyModuleMarketplaceModule.IconV2, and is useful for accessing the field via an interface.
+func (v *GetPublishedModuleMyModuleMarketplaceModule) GetIconV2() *AppTileModuleIconV2MarketplaceModuleImage {
+	return v.AppTileModule.IconV2
+}
+
+// GetPreviewImagesV2 returns GetPublishedModuleMyModuleMarketplaceModule.PreviewImagesV2, and is useful for accessing the field via an interface.
+func (v *GetPublishedModuleMyModuleMarketplaceModule) GetPreviewImagesV2() *AppTileModulePreviewImagesV2MarketplaceModulePreviewImages {
+	return v.AppTileModule.PreviewImagesV2
+}
+
+func (v *GetPublishedModuleMyModuleMarketplaceModule) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*GetPublishedModuleMyModuleMarketplaceModule
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.GetPublishedModuleMyModuleMarketplaceModule = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.AppTileModule)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalGetPublishedModuleMyModuleMarketplaceModule struct {
+	Title string `json:"title"`
+
+	Description string `json:"description"`
+
+	Version string `json:"version"`
+
+	Tags []string `json:"tags"`
+
+	Prices []AppTileModulePricesModulePrice `json:"prices"`
+
+	Source json.RawMessage `json:"source"`
+
+	IconV2 *AppTileModuleIconV2MarketplaceModuleImage `json:"iconV2"`
+
+	PreviewImagesV2 *AppTileModulePreviewImagesV2MarketplaceModulePreviewImages `json:"previewImagesV2"`
+}
+
+func (v *GetPublishedModuleMyModuleMarketplaceModule) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *GetPublishedModuleMyModuleMarketplaceModule) __premarshalJSON() (*__premarshalGetPublishedModuleMyModuleMarketplaceModule, error) {
+	var retval __premarshalGetPublishedModuleMyModuleMarketplaceModule
+
+	retval.Title = v.AppTileModule.Title
+	retval.Description = v.AppTileModule.Description
+	retval.Version = v.AppTileModule.Version
+	retval.Tags = v.AppTileModule.Tags
+	retval.Prices = v.AppTileModule.Prices
+	{
+
+		dst := &retval.Source
+		src := v.AppTileModule.Source
+		var err error
+		*dst, err = __marshalAppTileModuleSourceMarketplaceModuleSource(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"Unable to marshal GetPublishedModuleMyModuleMarketplaceModule.AppTileModule.Source: %w", err)
+		}
+	}
+	retval.IconV2 = v.AppTileModule.IconV2
+	retval.PreviewImagesV2 = v.AppTileModule.PreviewImagesV2
+	return &retval, nil
+}
+
+// GetPublishedModuleResponse is returned by GetPublishedModule on success.
+type GetPublishedModuleResponse struct {
+	MyModule GetPublishedModuleMyModuleMarketplaceModule `json:"myModule"`
+}
+
+// GetMyModule returns GetPublishedModuleResponse.MyModule, and is useful for accessing the field via an interface.
+func (v *GetPublishedModuleResponse) GetMyModule() GetPublishedModuleMyModuleMarketplaceModule {
+	return v.MyModule
+}
+
+// GetReviewRepliesMyModuleMarketplaceModule includes the requested fields of the GraphQL type MarketplaceModule.
+type GetReviewRepliesMyModuleMarketplaceModule struct {
+	Reviews GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnection `json:"reviews"`
+}
+
+// GetReviews returns GetReviewRepliesMyModuleMarketplaceModule.Reviews, and is useful for accessing the field via an interface.
+func (v *GetReviewRepliesMyModuleMarketplaceModule) GetReviews() GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnection {
+	return v.Reviews
+}
+
+// GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnection includes the requested fields of the GraphQL type ReviewConnection.
+type GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnection struct {
+	Edges    []GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdge `json:"edges"`
+	PageInfo GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionPageInfo          `json:"pageInfo"`
+}
+
+// GetEdges returns GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnection.Edges, and is useful for accessing the field via an interface.
+func (v *GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnection) GetEdges() []GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdge {
+	return v.Edges
+}
+
+// GetPageInfo returns GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnection) GetPageInfo() GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionPageInfo {
+	return v.PageInfo
+}
+
+// GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdge includes the requested fields of the GraphQL type ReviewEdge.
+type GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdge struct {
+	Node GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReview `json:"node"`
+}
+
+// GetNode returns GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdge.Node, and is useful for accessing the field via an interface.
+func (v *GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdge) GetNode() GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReview {
+	return v.Node
+}
+
+// GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReview includes the requested fields of the GraphQL type Review.
+type GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReview struct {
+	Id      string                                                                                               `json:"id"`
+	Comment GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentPost `json:"-"`
+}
+
+// GetId returns GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReview.Id, and is useful for accessing the field via an interface.
+func (v *GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReview) GetId() string {
+	return v.Id
+}
+
+// GetComment returns GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReview.Comment, and is useful for accessing the field via an interface.
+func (v *GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReview) GetComment() GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentPost {
+	return v.Comment
+}
+
+func (v *GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReview) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReview
+		Comment json.RawMessage `json:"comment"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReview = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Comment
+		src := firstPass.Comment
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalGetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentPost(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"Unable to unmarshal GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReview.Comment: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalGetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReview struct {
+	Id string `json:"id"`
+
+	Comment json.RawMessage `json:"comment"`
+}
+
+func (v *GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReview) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReview) __premarshalJSON() (*__premarshalGetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReview, error) {
+	var retval __premarshalGetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReview
+
+	retval.Id = v.Id
+	{
+
+		dst := &retval.Comment
+		src := v.Comment
+		var err error
+		*dst, err = __marshalGetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentPost(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"Unable to marshal GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReview.Comment: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+// GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentActivePost includes the requested fields of the GraphQL type ActivePost.
+type GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentActivePost struct {
+	Typename string                                                                                                                    `json:"__typename"`
+	Replies  GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentPostRepliesPostConnection `json:"replies"`
+}
+
+// GetTypename returns GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentActivePost.Typename, and is useful for accessing the field via an interface.
+func (v *GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentActivePost) GetTypename() string {
+	return v.Typename
+}
+
+// GetReplies returns GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentActivePost.Replies, and is useful for accessing the field via an interface.
+func (v *GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentActivePost) GetReplies() GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentPostRepliesPostConnection {
+	return v.Replies
+}
+
+// GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentDeletedPost includes the requested fields of the GraphQL type DeletedPost.
+type GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentDeletedPost struct {
+	Typename string                                                                                                                    `json:"__typename"`
+	Replies  GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentPostRepliesPostConnection `json:"replies"`
+}
+
+// GetTypename returns GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentDeletedPost.Typename, and is useful for accessing the field via an interface.
+func (v *GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentDeletedPost) GetTypename() string {
+	return v.Typename
+}
+
+// GetReplies returns GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentDeletedPost.Replies, and is useful for accessing the field via an interface.
+func (v *GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentDeletedPost) GetReplies() GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentPostRepliesPostConnection {
+	return v.Replies
+}
+
+// GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentPost includes the requested fields of the GraphQL interface Post.
+//
+// GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentPost is implemented by the following types:
+// GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentActivePost
+// GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentDeletedPost
+type GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentPost interface {
+	implementsGraphQLInterfaceGetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentPost()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() string
+	// GetReplies returns the interface-field "replies" from its implementation.
+	GetReplies() GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentPostRepliesPostConnection
+}
+
+func (v *GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentActivePost) implementsGraphQLInterfaceGetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentPost() {
+}
+func (v *GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentDeletedPost) implementsGraphQLInterfaceGetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentPost() {
+}
+
+func __unmarshalGetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentPost(b []byte, v *GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentPost) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "ActivePost":
+		*v = new(GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentActivePost)
+		return json.Unmarshal(b, *v)
+	case "DeletedPost":
+		*v = new(GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentDeletedPost)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing Post.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentPost: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalGetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentPost(v *GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentPost) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentActivePost:
+		typename = "ActivePost"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentActivePost
+		}{typename, v}
+		return json.Marshal(result)
+	case *GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentDeletedPost:
+		typename = "DeletedPost"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentDeletedPost
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentPost: "%T"`, v)
+	}
+}
+
+// GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentPostRepliesPostConnection includes the requested fields of the GraphQL type PostConnection.
+type GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentPostRepliesPostConnection struct {
+	Edges    []GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentPostRepliesPostConnectionEdgesPostEdge `json:"edges"`
+	PageInfo GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentPostRepliesPostConnectionPageInfo        `json:"pageInfo"`
+}
+
+// GetEdges returns GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentPostRepliesPostConnection.Edges, and is useful for accessing the field via an interface.
+func (v *GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentPostRepliesPostConnection) GetEdges() []GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentPostRepliesPostConnectionEdgesPostEdge {
+	return v.Edges
+}
+
+// GetPageInfo returns GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentPostRepliesPostConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentPostRepliesPostConnection) GetPageInfo() GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentPostRepliesPostConnectionPageInfo {
+	return v.PageInfo
+}
+
+// GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentPostRepliesPostConnectionEdgesPostEdge includes the requested fields of the GraphQL type PostEdge.
+type GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentPostRepliesPostConnectionEdgesPostEdge struct {
+	Node GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentPostRepliesPostConnectionEdgesPostEdgeNodePost `json:"-"`
+}
+
+// GetNode returns GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentPostRepliesPostConnectionEdgesPostEdge.Node, and is useful for accessing the field via an interface.
+func (v *GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentPostRepliesPostConnectionEdgesPostEdge) GetNode() GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentPostRepliesPostConnectionEdgesPostEdgeNodePost {
+	return v.Node
+}
+
+func (v *GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentPostRepliesPostConnectionEdgesPostEdge) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentPostRepliesPostConnectionEdgesPostEdge
+		Node json.RawMessage `json:"node"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentPostRepliesPostConnectionEdgesPostEdge = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Node
+		src := firstPass.Node
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalGetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentPostRepliesPostConnectionEdgesPostEdgeNodePost(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"Unable to unmarshal GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentPostRepliesPostConnectionEdgesPostEdge.Node: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalGetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentPostRepliesPostConnectionEdgesPostEdge struct {
+	Node json.RawMessage `json:"node"`
+}
+
+func (v *GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentPostRepliesPostConnectionEdgesPostEdge) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentPostRepliesPostConnectionEdgesPostEdge) __premarshalJSON() (*__premarshalGetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentPostRepliesPostConnectionEdgesPostEdge, error) {
+	var retval __premarshalGetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentPostRepliesPostConnectionEdgesPostEdge
+
+	{
+
+		dst := &retval.Node
+		src := v.Node
+		var err error
+		*dst, err = __marshalGetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentPostRepliesPostConnectionEdgesPostEdgeNodePost(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"Unable to marshal GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentPostRepliesPostConnectionEdgesPostEdge.Node: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+// GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentPostRepliesPostConnectionEdgesPostEdgeNodeActivePost includes the requested fields of the GraphQL type ActivePost.
+type GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentPostRepliesPostConnectionEdgesPostEdgeNodeActivePost struct {
+	Typename                    string `json:"__typename"`
+	ReviewReplyFieldsActivePost `json:"-"`
+}
+
+// GetTypename returns GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentPostRepliesPostConnectionEdgesPostEdgeNodeActivePost.Typename, and is useful for accessing the field via an interface.
+func (v *GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentPostRepliesPostConnectionEdgesPostEdgeNodeActivePost) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentPostRepliesPostConnectionEdgesPostEdgeNodeActivePost.Id, and is useful for accessing the field via an interface.
+func (v *GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentPostRepliesPostConnectionEdgesPostEdgeNodeActivePost) GetId() string {
+	return v.ReviewReplyFieldsActivePost.Id
+}
+
+// GetMessage returns GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentPostRepliesPostConnectionEdgesPostEdgeNodeActivePost.Message, and is useful for accessing the field via an interface.
+func (v *GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentPostRepliesPostConnectionEdgesPostEdgeNodeActivePost) GetMessage() string {
+	return v.ReviewReplyFieldsActivePost.Message
+}
+
+// GetAuthorId returns GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentPostRepliesPostConnectionEdgesPostEdgeNodeActivePost.AuthorId, and is useful for accessing the field via an interface.
+func (v *GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentPostRepliesPostConnectionEdgesPostEdgeNodeActivePost) GetAuthorId() string {
+	return v.ReviewReplyFieldsActivePost.AuthorId
+}
+
+func (v *GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentPostRepliesPostConnectionEdgesPostEdgeNodeActivePost) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentPostRepliesPostConnectionEdgesPostEdgeNodeActivePost
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentPostRepliesPostConnectionEdgesPostEdgeNodeActivePost = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.ReviewReplyFieldsActivePost)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalGetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentPostRepliesPostConnectionEdgesPostEdgeNodeActivePost struct {
+	Typename string `json:"__typename"`
+
+	Id string `json:"id"`
+
+	Message string `json:"message"`
+
+	AuthorId string `json:"authorId"`
+}
+
+func (v *GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentPostRepliesPostConnectionEdgesPostEdgeNodeActivePost) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentPostRepliesPostConnectionEdgesPostEdgeNodeActivePost) __premarshalJSON() (*__premarshalGetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentPostRepliesPostConnectionEdgesPostEdgeNodeActivePost, error) {
+	var retval __premarshalGetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentPostRepliesPostConnectionEdgesPostEdgeNodeActivePost
+
+	retval.Typename = v.Typename
+	retval.Id = v.ReviewReplyFieldsActivePost.Id
+	retval.Message = v.ReviewReplyFieldsActivePost.Message
+	retval.AuthorId = v.ReviewReplyFieldsActivePost.AuthorId
+	return &retval, nil
+}
+
+// GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentPostRepliesPostConnectionEdgesPostEdgeNodeDeletedPost includes the requested fields of the GraphQL type DeletedPost.
+type GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentPostRepliesPostConnectionEdgesPostEdgeNodeDeletedPost struct {
+	Typename                     string `json:"__typename"`
+	ReviewReplyFieldsDeletedPost `json:"-"`
+}
+
+// GetTypename returns GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentPostRepliesPostConnectionEdgesPostEdgeNodeDeletedPost.Typename, and is useful for accessing the field via an interface.
+func (v *GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentPostRepliesPostConnectionEdgesPostEdgeNodeDeletedPost) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentPostRepliesPostConnectionEdgesPostEdgeNodeDeletedPost.Id, and is useful for accessing the field via an interface.
+func (v *GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentPostRepliesPostConnectionEdgesPostEdgeNodeDeletedPost) GetId() string {
+	return v.ReviewReplyFieldsDeletedPost.Id
+}
+
+func (v *GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentPostRepliesPostConnectionEdgesPostEdgeNodeDeletedPost) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentPostRepliesPostConnectionEdgesPostEdgeNodeDeletedPost
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentPostRepliesPostConnectionEdgesPostEdgeNodeDeletedPost = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.ReviewReplyFieldsDeletedPost)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalGetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentPostRepliesPostConnectionEdgesPostEdgeNodeDeletedPost struct {
+	Typename string `json:"__typename"`
+
+	Id string `json:"id"`
+}
+
+func (v *GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentPostRepliesPostConnectionEdgesPostEdgeNodeDeletedPost) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentPostRepliesPostConnectionEdgesPostEdgeNodeDeletedPost) __premarshalJSON() (*__premarshalGetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentPostRepliesPostConnectionEdgesPostEdgeNodeDeletedPost, error) {
+	var retval __premarshalGetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentPostRepliesPostConnectionEdgesPostEdgeNodeDeletedPost
+
+	retval.Typename = v.Typename
+	retval.Id = v.ReviewReplyFieldsDeletedPost.Id
+	return &retval, nil
+}
+
+// GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentPostRepliesPostConnectionEdgesPostEdgeNodePost includes the requested fields of the GraphQL interface Post.
+//
+// GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentPostRepliesPostConnectionEdgesPostEdgeNodePost is implemented by the following types:
+// GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentPostRepliesPostConnectionEdgesPostEdgeNodeActivePost
+// GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentPostRepliesPostConnectionEdgesPostEdgeNodeDeletedPost
+type GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentPostRepliesPostConnectionEdgesPostEdgeNodePost interface {
+	implementsGraphQLInterfaceGetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentPostRepliesPostConnectionEdgesPostEdgeNodePost()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() string
+	ReviewReplyFields
+}
+
+func (v *GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentPostRepliesPostConnectionEdgesPostEdgeNodeActivePost) implementsGraphQLInterfaceGetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentPostRepliesPostConnectionEdgesPostEdgeNodePost() {
+}
+func (v *GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentPostRepliesPostConnectionEdgesPostEdgeNodeDeletedPost) implementsGraphQLInterfaceGetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentPostRepliesPostConnectionEdgesPostEdgeNodePost() {
+}
+
+func __unmarshalGetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentPostRepliesPostConnectionEdgesPostEdgeNodePost(b []byte, v *GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentPostRepliesPostConnectionEdgesPostEdgeNodePost) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "ActivePost":
+		*v = new(GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentPostRepliesPostConnectionEdgesPostEdgeNodeActivePost)
+		return json.Unmarshal(b, *v)
+	case "DeletedPost":
+		*v = new(GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentPostRepliesPostConnectionEdgesPostEdgeNodeDeletedPost)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing Post.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentPostRepliesPostConnectionEdgesPostEdgeNodePost: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalGetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentPostRepliesPostConnectionEdgesPostEdgeNodePost(v *GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentPostRepliesPostConnectionEdgesPostEdgeNodePost) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentPostRepliesPostConnectionEdgesPostEdgeNodeActivePost:
+		typename = "ActivePost"
+
+		premarshaled, err := v.__premarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*__premarshalGetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentPostRepliesPostConnectionEdgesPostEdgeNodeActivePost
+		}{typename, premarshaled}
+		return json.Marshal(result)
+	case *GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentPostRepliesPostConnectionEdgesPostEdgeNodeDeletedPost:
+		typename = "DeletedPost"
+
+		premarshaled, err := v.__premarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*__premarshalGetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentPostRepliesPostConnectionEdgesPostEdgeNodeDeletedPost
+		}{typename, premarshaled}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentPostRepliesPostConnectionEdgesPostEdgeNodePost: "%T"`, v)
+	}
+}
+
+// GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentPostRepliesPostConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+type GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentPostRepliesPostConnectionPageInfo struct {
+	EndCursor   string `json:"endCursor"`
+	HasNextPage bool   `json:"hasNextPage"`
+}
+
+// GetEndCursor returns GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentPostRepliesPostConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentPostRepliesPostConnectionPageInfo) GetEndCursor() string {
+	return v.EndCursor
+}
+
+// GetHasNextPage returns GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentPostRepliesPostConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionEdgesReviewEdgeNodeReviewCommentPostRepliesPostConnectionPageInfo) GetHasNextPage() bool {
+	return v.HasNextPage
+}
+
+// GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+type GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionPageInfo struct {
+	EndCursor   string `json:"endCursor"`
+	HasNextPage bool   `json:"hasNextPage"`
+}
+
+// GetEndCursor returns GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionPageInfo) GetEndCursor() string {
+	return v.EndCursor
+}
+
+// GetHasNextPage returns GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *GetReviewRepliesMyModuleMarketplaceModuleReviewsReviewConnectionPageInfo) GetHasNextPage() bool {
+	return v.HasNextPage
+}
+
+// GetReviewRepliesResponse is returned by GetReviewReplies on success.
+type GetReviewRepliesResponse struct {
+	MyModule GetReviewRepliesMyModuleMarketplaceModule `json:"myModule"`
+}
+
+// GetMyModule returns GetReviewRepliesResponse.MyModule, and is useful for accessing the field via an interface.
+func (v *GetReviewRepliesResponse) GetMyModule() GetReviewRepliesMyModuleMarketplaceModule {
+	return v.MyModule
+}
+
+// GetUserPurchasedModuleResponse is returned by GetUserPurchasedModule on success.
+type GetUserPurchasedModuleResponse struct {
+	UserPurchasedModule GetUserPurchasedModuleUserPurchasedModuleUserPurchasedModuleResponse `json:"userPurchasedModule"`
+}
+
+// GetUserPurchasedModule returns GetUserPurchasedModuleResponse.UserPurchasedModule, and is useful for accessing the field via an interface.
+func (v *GetUserPurchasedModuleResponse) GetUserPurchasedModule() GetUserPurchasedModuleUserPurchasedModuleUserPurchasedModuleResponse {
+	return v.UserPurchasedModule
+}
+
+// GetUserPurchasedModuleUserPurchasedModuleUserPurchasedModuleResponse includes the requested fields of the GraphQL type UserPurchasedModuleResponse.
+type GetUserPurchasedModuleUserPurchasedModuleUserPurchasedModuleResponse struct {
+	Purchased bool                                                                          `json:"purchased"`
+	Purchase  *GetUserPurchasedModuleUserPurchasedModuleUserPurchasedModuleResponsePurchase `json:"purchase"`
+}
+
+// GetPurchased returns GetUserPurchasedModuleUserPurchasedModuleUserPurchasedModuleResponse.Purchased, and is useful for accessing the field via an interface.
+func (v *GetUserPurchasedModuleUserPurchasedModuleUserPurchasedModuleResponse) GetPurchased() bool {
+	return v.Purchased
+}
+
+// GetPurchase returns GetUserPurchasedModuleUserPurchasedModuleUserPurchasedModuleResponse.Purchase, and is useful for accessing the field via an interface.
+func (v *GetUserPurchasedModuleUserPurchasedModuleUserPurchasedModuleResponse) GetPurchase() *GetUserPurchasedModuleUserPurchasedModuleUserPurchasedModuleResponsePurchase {
+	return v.Purchase
+}
+
+// GetUserPurchasedModuleUserPurchasedModuleUserPurchasedModuleResponsePurchase includes the requested fields of the GraphQL type Purchase.
+type GetUserPurchasedModuleUserPurchasedModuleUserPurchasedModuleResponsePurchase struct {
+	PurchaseFields `json:"-"`
+}
+
+// GetPurchaseId returns GetUserPurchasedModuleUserPurchasedModuleUserPurchasedModuleResponsePurchase.PurchaseId, and is useful for accessing the field via an interface.
+func (v *GetUserPurchasedModuleUserPurchasedModuleUserPurchasedModuleResponsePurchase) GetPurchaseId() string {
+	return v.PurchaseFields.PurchaseId
+}
+
+// GetModuleId returns GetUserPurchasedModuleUserPurchasedModuleUserPurchasedModuleResponsePurchase.ModuleId, and is useful for accessing the field via an interface.
+func (v *GetUserPurchasedModuleUserPurchasedModuleUserPurchasedModuleResponsePurchase) GetModuleId() string {
+	return v.PurchaseFields.ModuleId
+}
+
+// GetStatus returns GetUserPurchasedModuleUserPurchasedModuleUserPurchasedModuleResponsePurchase.Status, and is useful for accessing the field via an interface.
+func (v *GetUserPurchasedModuleUserPurchasedModuleUserPurchasedModuleResponsePurchase) GetStatus() PurchaseStatus {
+	return v.PurchaseFields.Status
+}
+
+// GetType returns GetUserPurchasedModuleUserPurchasedModuleUserPurchasedModuleResponsePurchase.Type, and is useful for accessing the field via an interface.
+func (v *GetUserPurchasedModuleUserPurchasedModuleUserPurchasedModuleResponsePurchase) GetType() PurchaseType {
+	return v.PurchaseFields.Type
+}
+
+// GetPurchasedAt returns GetUserPurchasedModuleUserPurchasedModuleUserPurchasedModuleResponsePurchase.PurchasedAt, and is useful for accessing the field via an interface.
+func (v *GetUserPurchasedModuleUserPurchasedModuleUserPurchasedModuleResponsePurchase) GetPurchasedAt() int64 {
+	return v.PurchaseFields.PurchasedAt
+}
+
+// GetCancelledAt returns GetUserPurchasedModuleUserPurchasedModuleUserPurchasedModuleResponsePurchase.CancelledAt, and is useful for accessing the field via an interface.
+func (v *GetUserPurchasedModuleUserPurchasedModuleUserPurchasedModuleResponsePurchase) GetCancelledAt() *int64 {
+	return v.PurchaseFields.CancelledAt
+}
+
+// GetModule returns GetUserPurchasedModuleUserPurchasedModuleUserPurchasedModuleResponsePurchase.Module, and is useful for accessing the field via an interface.
+func (v *GetUserPurchasedModuleUserPurchasedModuleUserPurchasedModuleResponsePurchase) GetModule() PurchaseFieldsModulePurchaseModule {
+	return v.PurchaseFields.Module
+}
+
+func (v *GetUserPurchasedModuleUserPurchasedModuleUserPurchasedModuleResponsePurchase) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*GetUserPurchasedModuleUserPurchasedModuleUserPurchasedModuleResponsePurchase
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.GetUserPurchasedModuleUserPurchasedModuleUserPurchasedModuleResponsePurchase = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.PurchaseFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalGetUserPurchasedModuleUserPurchasedModuleUserPurchasedModuleResponsePurchase struct {
+	PurchaseId string `json:"purchaseId"`
+
+	ModuleId string `json:"moduleId"`
+
+	Status PurchaseStatus `json:"status"`
+
+	Type PurchaseType `json:"type"`
+
+	PurchasedAt int64 `json:"purchasedAt"`
+
+	CancelledAt *int64 `json:"cancelledAt"`
+
+	Module json.RawMessage `json:"module"`
+}
+
+func (v *GetUserPurchasedModuleUserPurchasedModuleUserPurchasedModuleResponsePurchase) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *GetUserPurchasedModuleUserPurchasedModuleUserPurchasedModuleResponsePurchase) __premarshalJSON() (*__premarshalGetUserPurchasedModuleUserPurchasedModuleUserPurchasedModuleResponsePurchase, error) {
+	var retval __premarshalGetUserPurchasedModuleUserPurchasedModuleUserPurchasedModuleResponsePurchase
+
+	retval.PurchaseId = v.PurchaseFields.PurchaseId
+	retval.ModuleId = v.PurchaseFields.ModuleId
+	retval.Status = v.PurchaseFields.Status
+	retval.Type = v.PurchaseFields.Type
+	retval.PurchasedAt = v.PurchaseFields.PurchasedAt
+	retval.CancelledAt = v.PurchaseFields.CancelledAt
+	{
+
+		dst := &retval.Module
+		src := v.PurchaseFields.Module
+		var err error
+		*dst, err = __marshalPurchaseFieldsModulePurchaseModule(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"Unable to marshal GetUserPurchasedModuleUserPurchasedModuleUserPurchasedModuleResponsePurchase.PurchaseFields.Module: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+// GetWellnessOfferingSourceModuleMarketplaceModule includes the requested fields of the GraphQL type MarketplaceModule.
+type GetWellnessOfferingSourceModuleMarketplaceModule struct {
+	Id       string                                                 `json:"id"`
+	Category ModuleCategory                                         `json:"category"`
+	Source   GetWellnessOfferingSourceModuleMarketplaceModuleSource `json:"-"`
+}
+
+// GetId returns GetWellnessOfferingSourceModuleMarketplaceModule.Id, and is useful for accessing the field via an interface.
+func (v *GetWellnessOfferingSourceModuleMarketplaceModule) GetId() string { return v.Id }
+
+// GetCategory returns GetWellnessOfferingSourceModuleMarketplaceModule.Category, and is useful for accessing the field via an interface.
+func (v *GetWellnessOfferingSourceModuleMarketplaceModule) GetCategory() ModuleCategory {
+	return v.Category
+}
+
+// GetSource returns GetWellnessOfferingSourceModuleMarketplaceModule.Source, and is useful for accessing the field via an interface.
+func (v *GetWellnessOfferingSourceModuleMarketplaceModule) GetSource() GetWellnessOfferingSourceModuleMarketplaceModuleSource {
+	return v.Source
+}
+
+func (v *GetWellnessOfferingSourceModuleMarketplaceModule) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*GetWellnessOfferingSourceModuleMarketplaceModule
+		Source json.RawMessage `json:"source"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.GetWellnessOfferingSourceModuleMarketplaceModule = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Source
+		src := firstPass.Source
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalGetWellnessOfferingSourceModuleMarketplaceModuleSource(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"Unable to unmarshal GetWellnessOfferingSourceModuleMarketplaceModule.Source: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalGetWellnessOfferingSourceModuleMarketplaceModule struct {
+	Id string `json:"id"`
+
+	Category ModuleCategory `json:"category"`
+
+	Source json.RawMessage `json:"source"`
+}
+
+func (v *GetWellnessOfferingSourceModuleMarketplaceModule) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *GetWellnessOfferingSourceModuleMarketplaceModule) __premarshalJSON() (*__premarshalGetWellnessOfferingSourceModuleMarketplaceModule, error) {
+	var retval __premarshalGetWellnessOfferingSourceModuleMarketplaceModule
+
+	retval.Id = v.Id
+	retval.Category = v.Category
+	{
+
+		dst := &retval.Source
+		src := v.Source
+		var err error
+		*dst, err = __marshalGetWellnessOfferingSourceModuleMarketplaceModuleSource(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"Unable to marshal GetWellnessOfferingSourceModuleMarketplaceModule.Source: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+// GetWellnessOfferingSourceModuleMarketplaceModuleSource includes the requested fields of the GraphQL interface MarketplaceModuleSource.
+//
+// GetWellnessOfferingSourceModuleMarketplaceModuleSource is implemented by the following types:
+// GetWellnessOfferingSourceModuleMarketplaceModuleSourceAppTile
+// GetWellnessOfferingSourceModuleMarketplaceModuleSourceConsent
+// GetWellnessOfferingSourceModuleMarketplaceModuleSourceDomainOntology
+// GetWellnessOfferingSourceModuleMarketplaceModuleSourceInsightsLayout
+// GetWellnessOfferingSourceModuleMarketplaceModuleSourceNotebook
+// GetWellnessOfferingSourceModuleMarketplaceModuleSourceOcrReportExtractor
+// GetWellnessOfferingSourceModuleMarketplaceModuleSourcePatientLayout
+// GetWellnessOfferingSourceModuleMarketplaceModuleSourceProcessOntology
+// GetWellnessOfferingSourceModuleMarketplaceModuleSourceProgramEnrollment
+// GetWellnessOfferingSourceModuleMarketplaceModuleSourceProgramTemplate
+// GetWellnessOfferingSourceModuleMarketplaceModuleSourceSearchLayout
+// GetWellnessOfferingSourceModuleMarketplaceModuleSourceSurvey
+// GetWellnessOfferingSourceModuleMarketplaceModuleSourceWellnessOffering
+// GetWellnessOfferingSourceModuleMarketplaceModuleSourceWorkflow
+type GetWellnessOfferingSourceModuleMarketplaceModuleSource interface {
+	implementsGraphQLInterfaceGetWellnessOfferingSourceModuleMarketplaceModuleSource()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() string
+}
+
+func (v *GetWellnessOfferingSourceModuleMarketplaceModuleSourceAppTile) implementsGraphQLInterfaceGetWellnessOfferingSourceModuleMarketplaceModuleSource() {
+}
+func (v *GetWellnessOfferingSourceModuleMarketplaceModuleSourceConsent) implementsGraphQLInterfaceGetWellnessOfferingSourceModuleMarketplaceModuleSource() {
+}
+func (v *GetWellnessOfferingSourceModuleMarketplaceModuleSourceDomainOntology) implementsGraphQLInterfaceGetWellnessOfferingSourceModuleMarketplaceModuleSource() {
+}
+func (v *GetWellnessOfferingSourceModuleMarketplaceModuleSourceInsightsLayout) implementsGraphQLInterfaceGetWellnessOfferingSourceModuleMarketplaceModuleSource() {
+}
+func (v *GetWellnessOfferingSourceModuleMarketplaceModuleSourceNotebook) implementsGraphQLInterfaceGetWellnessOfferingSourceModuleMarketplaceModuleSource() {
+}
+func (v *GetWellnessOfferingSourceModuleMarketplaceModuleSourceOcrReportExtractor) implementsGraphQLInterfaceGetWellnessOfferingSourceModuleMarketplaceModuleSource() {
+}
+func (v *GetWellnessOfferingSourceModuleMarketplaceModuleSourcePatientLayout) implementsGraphQLInterfaceGetWellnessOfferingSourceModuleMarketplaceModuleSource() {
+}
+func (v *GetWellnessOfferingSourceModuleMarketplaceModuleSourceProcessOntology) implementsGraphQLInterfaceGetWellnessOfferingSourceModuleMarketplaceModuleSource() {
+}
+func (v *GetWellnessOfferingSourceModuleMarketplaceModuleSourceProgramEnrollment) implementsGraphQLInterfaceGetWellnessOfferingSourceModuleMarketplaceModuleSource() {
+}
+func (v *GetWellnessOfferingSourceModuleMarketplaceModuleSourceProgramTemplate) implementsGraphQLInterfaceGetWellnessOfferingSourceModuleMarketplaceModuleSource() {
+}
+func (v *GetWellnessOfferingSourceModuleMarketplaceModuleSourceSearchLayout) implementsGraphQLInterfaceGetWellnessOfferingSourceModuleMarketplaceModuleSource() {
+}
+func (v *GetWellnessOfferingSourceModuleMarketplaceModuleSourceSurvey) implementsGraphQLInterfaceGetWellnessOfferingSourceModuleMarketplaceModuleSource() {
+}
+func (v *GetWellnessOfferingSourceModuleMarketplaceModuleSourceWellnessOffering) implementsGraphQLInterfaceGetWellnessOfferingSourceModuleMarketplaceModuleSource() {
+}
+func (v *GetWellnessOfferingSourceModuleMarketplaceModuleSourceWorkflow) implementsGraphQLInterfaceGetWellnessOfferingSourceModuleMarketplaceModuleSource() {
+}
+
+func __unmarshalGetWellnessOfferingSourceModuleMarketplaceModuleSource(b []byte, v *GetWellnessOfferingSourceModuleMarketplaceModuleSource) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "AppTile":
+		*v = new(GetWellnessOfferingSourceModuleMarketplaceModuleSourceAppTile)
+		return json.Unmarshal(b, *v)
+	case "Consent":
+		*v = new(GetWellnessOfferingSourceModuleMarketplaceModuleSourceConsent)
+		return json.Unmarshal(b, *v)
+	case "DomainOntology":
+		*v = new(GetWellnessOfferingSourceModuleMarketplaceModuleSourceDomainOntology)
+		return json.Unmarshal(b, *v)
+	case "InsightsLayout":
+		*v = new(GetWellnessOfferingSourceModuleMarketplaceModuleSourceInsightsLayout)
+		return json.Unmarshal(b, *v)
+	case "Notebook":
+		*v = new(GetWellnessOfferingSourceModuleMarketplaceModuleSourceNotebook)
+		return json.Unmarshal(b, *v)
+	case "OcrReportExtractor":
+		*v = new(GetWellnessOfferingSourceModuleMarketplaceModuleSourceOcrReportExtractor)
+		return json.Unmarshal(b, *v)
+	case "PatientLayout":
+		*v = new(GetWellnessOfferingSourceModuleMarketplaceModuleSourcePatientLayout)
+		return json.Unmarshal(b, *v)
+	case "ProcessOntology":
+		*v = new(GetWellnessOfferingSourceModuleMarketplaceModuleSourceProcessOntology)
+		return json.Unmarshal(b, *v)
+	case "ProgramEnrollment":
+		*v = new(GetWellnessOfferingSourceModuleMarketplaceModuleSourceProgramEnrollment)
+		return json.Unmarshal(b, *v)
+	case "ProgramTemplate":
+		*v = new(GetWellnessOfferingSourceModuleMarketplaceModuleSourceProgramTemplate)
+		return json.Unmarshal(b, *v)
+	case "SearchLayout":
+		*v = new(GetWellnessOfferingSourceModuleMarketplaceModuleSourceSearchLayout)
+		return json.Unmarshal(b, *v)
+	case "Survey":
+		*v = new(GetWellnessOfferingSourceModuleMarketplaceModuleSourceSurvey)
+		return json.Unmarshal(b, *v)
+	case "WellnessOffering":
+		*v = new(GetWellnessOfferingSourceModuleMarketplaceModuleSourceWellnessOffering)
+		return json.Unmarshal(b, *v)
+	case "Workflow":
+		*v = new(GetWellnessOfferingSourceModuleMarketplaceModuleSourceWorkflow)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing MarketplaceModuleSource.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for GetWellnessOfferingSourceModuleMarketplaceModuleSource: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalGetWellnessOfferingSourceModuleMarketplaceModuleSource(v *GetWellnessOfferingSourceModuleMarketplaceModuleSource) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *GetWellnessOfferingSourceModuleMarketplaceModuleSourceAppTile:
+		typename = "AppTile"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetWellnessOfferingSourceModuleMarketplaceModuleSourceAppTile
+		}{typename, v}
+		return json.Marshal(result)
+	case *GetWellnessOfferingSourceModuleMarketplaceModuleSourceConsent:
+		typename = "Consent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetWellnessOfferingSourceModuleMarketplaceModuleSourceConsent
+		}{typename, v}
+		return json.Marshal(result)
+	case *GetWellnessOfferingSourceModuleMarketplaceModuleSourceDomainOntology:
+		typename = "DomainOntology"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetWellnessOfferingSourceModuleMarketplaceModuleSourceDomainOntology
+		}{typename, v}
+		return json.Marshal(result)
+	case *GetWellnessOfferingSourceModuleMarketplaceModuleSourceInsightsLayout:
+		typename = "InsightsLayout"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetWellnessOfferingSourceModuleMarketplaceModuleSourceInsightsLayout
+		}{typename, v}
+		return json.Marshal(result)
+	case *GetWellnessOfferingSourceModuleMarketplaceModuleSourceNotebook:
+		typename = "Notebook"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetWellnessOfferingSourceModuleMarketplaceModuleSourceNotebook
+		}{typename, v}
+		return json.Marshal(result)
+	case *GetWellnessOfferingSourceModuleMarketplaceModuleSourceOcrReportExtractor:
+		typename = "OcrReportExtractor"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetWellnessOfferingSourceModuleMarketplaceModuleSourceOcrReportExtractor
+		}{typename, v}
+		return json.Marshal(result)
+	case *GetWellnessOfferingSourceModuleMarketplaceModuleSourcePatientLayout:
+		typename = "PatientLayout"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetWellnessOfferingSourceModuleMarketplaceModuleSourcePatientLayout
+		}{typename, v}
+		return json.Marshal(result)
+	case *GetWellnessOfferingSourceModuleMarketplaceModuleSourceProcessOntology:
+		typename = "ProcessOntology"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetWellnessOfferingSourceModuleMarketplaceModuleSourceProcessOntology
+		}{typename, v}
+		return json.Marshal(result)
+	case *GetWellnessOfferingSourceModuleMarketplaceModuleSourceProgramEnrollment:
+		typename = "ProgramEnrollment"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetWellnessOfferingSourceModuleMarketplaceModuleSourceProgramEnrollment
+		}{typename, v}
+		return json.Marshal(result)
+	case *GetWellnessOfferingSourceModuleMarketplaceModuleSourceProgramTemplate:
+		typename = "ProgramTemplate"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetWellnessOfferingSourceModuleMarketplaceModuleSourceProgramTemplate
+		}{typename, v}
+		return json.Marshal(result)
+	case *GetWellnessOfferingSourceModuleMarketplaceModuleSourceSearchLayout:
+		typename = "SearchLayout"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetWellnessOfferingSourceModuleMarketplaceModuleSourceSearchLayout
+		}{typename, v}
+		return json.Marshal(result)
+	case *GetWellnessOfferingSourceModuleMarketplaceModuleSourceSurvey:
+		typename = "Survey"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetWellnessOfferingSourceModuleMarketplaceModuleSourceSurvey
+		}{typename, v}
+		return json.Marshal(result)
+	case *GetWellnessOfferingSourceModuleMarketplaceModuleSourceWellnessOffering:
+		typename = "WellnessOffering"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetWellnessOfferingSourceModuleMarketplaceModuleSourceWellnessOffering
+		}{typename, v}
+		return json.Marshal(result)
+	case *GetWellnessOfferingSourceModuleMarketplaceModuleSourceWorkflow:
+		typename = "Workflow"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*GetWellnessOfferingSourceModuleMarketplaceModuleSourceWorkflow
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for GetWellnessOfferingSourceModuleMarketplaceModuleSource: "%T"`, v)
+	}
+}
+
+// GetWellnessOfferingSourceModuleMarketplaceModuleSourceAppTile includes the requested fields of the GraphQL type AppTile.
+type GetWellnessOfferingSourceModuleMarketplaceModuleSourceAppTile struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns GetWellnessOfferingSourceModuleMarketplaceModuleSourceAppTile.Typename, and is useful for accessing the field via an interface.
+func (v *GetWellnessOfferingSourceModuleMarketplaceModuleSourceAppTile) GetTypename() string {
+	return v.Typename
+}
+
+// GetWellnessOfferingSourceModuleMarketplaceModuleSourceConsent includes the requested fields of the GraphQL type Consent.
+type GetWellnessOfferingSourceModuleMarketplaceModuleSourceConsent struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns GetWellnessOfferingSourceModuleMarketplaceModuleSourceConsent.Typename, and is useful for accessing the field via an interface.
+func (v *GetWellnessOfferingSourceModuleMarketplaceModuleSourceConsent) GetTypename() string {
+	return v.Typename
+}
+
+// GetWellnessOfferingSourceModuleMarketplaceModuleSourceDomainOntology includes the requested fields of the GraphQL type DomainOntology.
+type GetWellnessOfferingSourceModuleMarketplaceModuleSourceDomainOntology struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns GetWellnessOfferingSourceModuleMarketplaceModuleSourceDomainOntology.Typename, and is useful for accessing the field via an interface.
+func (v *GetWellnessOfferingSourceModuleMarketplaceModuleSourceDomainOntology) GetTypename() string {
+	return v.Typename
+}
+
+// GetWellnessOfferingSourceModuleMarketplaceModuleSourceInsightsLayout includes the requested fields of the GraphQL type InsightsLayout.
+type GetWellnessOfferingSourceModuleMarketplaceModuleSourceInsightsLayout struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns GetWellnessOfferingSourceModuleMarketplaceModuleSourceInsightsLayout.Typename, and is useful for accessing the field via an interface.
+func (v *GetWellnessOfferingSourceModuleMarketplaceModuleSourceInsightsLayout) GetTypename() string {
+	return v.Typename
+}
+
+// GetWellnessOfferingSourceModuleMarketplaceModuleSourceNotebook includes the requested fields of the GraphQL type Notebook.
+type GetWellnessOfferingSourceModuleMarketplaceModuleSourceNotebook struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns GetWellnessOfferingSourceModuleMarketplaceModuleSourceNotebook.Typename, and is useful for accessing the field via an interface.
+func (v *GetWellnessOfferingSourceModuleMarketplaceModuleSourceNotebook) GetTypename() string {
+	return v.Typename
+}
+
+// GetWellnessOfferingSourceModuleMarketplaceModuleSourceOcrReportExtractor includes the requested fields of the GraphQL type OcrReportExtractor.
+type GetWellnessOfferingSourceModuleMarketplaceModuleSourceOcrReportExtractor struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns GetWellnessOfferingSourceModuleMarketplaceModuleSourceOcrReportExtractor.Typename, and is useful for accessing the field via an interface.
+func (v *GetWellnessOfferingSourceModuleMarketplaceModuleSourceOcrReportExtractor) GetTypename() string {
+	return v.Typename
+}
+
+// GetWellnessOfferingSourceModuleMarketplaceModuleSourcePatientLayout includes the requested fields of the GraphQL type PatientLayout.
+type GetWellnessOfferingSourceModuleMarketplaceModuleSourcePatientLayout struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns GetWellnessOfferingSourceModuleMarketplaceModuleSourcePatientLayout.Typename, and is useful for accessing the field via an interface.
+func (v *GetWellnessOfferingSourceModuleMarketplaceModuleSourcePatientLayout) GetTypename() string {
+	return v.Typename
+}
+
+// GetWellnessOfferingSourceModuleMarketplaceModuleSourceProcessOntology includes the requested fields of the GraphQL type ProcessOntology.
+type GetWellnessOfferingSourceModuleMarketplaceModuleSourceProcessOntology struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns GetWellnessOfferingSourceModuleMarketplaceModuleSourceProcessOntology.Typename, and is useful for accessing the field via an interface.
+func (v *GetWellnessOfferingSourceModuleMarketplaceModuleSourceProcessOntology) GetTypename() string {
+	return v.Typename
+}
+
+// GetWellnessOfferingSourceModuleMarketplaceModuleSourceProgramEnrollment includes the requested fields of the GraphQL type ProgramEnrollment.
+type GetWellnessOfferingSourceModuleMarketplaceModuleSourceProgramEnrollment struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns GetWellnessOfferingSourceModuleMarketplaceModuleSourceProgramEnrollment.Typename, and is useful for accessing the field via an interface.
+func (v *GetWellnessOfferingSourceModuleMarketplaceModuleSourceProgramEnrollment) GetTypename() string {
+	return v.Typename
+}
+
+// GetWellnessOfferingSourceModuleMarketplaceModuleSourceProgramTemplate includes the requested fields of the GraphQL type ProgramTemplate.
+type GetWellnessOfferingSourceModuleMarketplaceModuleSourceProgramTemplate struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns GetWellnessOfferingSourceModuleMarketplaceModuleSourceProgramTemplate.Typename, and is useful for accessing the field via an interface.
+func (v *GetWellnessOfferingSourceModuleMarketplaceModuleSourceProgramTemplate) GetTypename() string {
+	return v.Typename
+}
+
+// GetWellnessOfferingSourceModuleMarketplaceModuleSourceSearchLayout includes the requested fields of the GraphQL type SearchLayout.
+type GetWellnessOfferingSourceModuleMarketplaceModuleSourceSearchLayout struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns GetWellnessOfferingSourceModuleMarketplaceModuleSourceSearchLayout.Typename, and is useful for accessing the field via an interface.
+func (v *GetWellnessOfferingSourceModuleMarketplaceModuleSourceSearchLayout) GetTypename() string {
+	return v.Typename
+}
+
+// GetWellnessOfferingSourceModuleMarketplaceModuleSourceSurvey includes the requested fields of the GraphQL type Survey.
+type GetWellnessOfferingSourceModuleMarketplaceModuleSourceSurvey struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns GetWellnessOfferingSourceModuleMarketplaceModuleSourceSurvey.Typename, and is useful for accessing the field via an interface.
+func (v *GetWellnessOfferingSourceModuleMarketplaceModuleSourceSurvey) GetTypename() string {
+	return v.Typename
+}
+
+// GetWellnessOfferingSourceModuleMarketplaceModuleSourceWellnessOffering includes the requested fields of the GraphQL type WellnessOffering.
+type GetWellnessOfferingSourceModuleMarketplaceModuleSourceWellnessOffering struct {
+	Typename string `json:"__typename"`
+	Id       string `json:"id"`
+	// The configuration schema for this offering, as a JSON blob.
+	ConfigurationSchema string `json:"configurationSchema"`
+}
+
+// GetTypename returns GetWellnessOfferingSourceModuleMarketplaceModuleSourceWellnessOffering.Typename, and is useful for accessing the field via an interface.
+func (v *GetWellnessOfferingSourceModuleMarketplaceModuleSourceWellnessOffering) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns GetWellnessOfferingSourceModuleMarketplaceModuleSourceWellnessOffering.Id, and is useful for accessing the field via an interface.
+func (v *GetWellnessOfferingSourceModuleMarketplaceModuleSourceWellnessOffering) GetId() string {
+	return v.Id
+}
+
+// GetConfigurationSchema returns GetWellnessOfferingSourceModuleMarketplaceModuleSourceWellnessOffering.ConfigurationSchema, and is useful for accessing the field via an interface.
+func (v *GetWellnessOfferingSourceModuleMarketplaceModuleSourceWellnessOffering) GetConfigurationSchema() string {
+	return v.ConfigurationSchema
+}
+
+// GetWellnessOfferingSourceModuleMarketplaceModuleSourceWorkflow includes the requested fields of the GraphQL type Workflow.
+type GetWellnessOfferingSourceModuleMarketplaceModuleSourceWorkflow struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns GetWellnessOfferingSourceModuleMarketplaceModuleSourceWorkflow.Typename, and is useful for accessing the field via an interface.
+func (v *GetWellnessOfferingSourceModuleMarketplaceModuleSourceWorkflow) GetTypename() string {
+	return v.Typename
+}
+
+// GetWellnessOfferingSourceResponse is returned by GetWellnessOfferingSource on success.
+type GetWellnessOfferingSourceResponse struct {
+	Module GetWellnessOfferingSourceModuleMarketplaceModule `json:"module"`
+}
+
+// GetModule returns GetWellnessOfferingSourceResponse.Module, and is useful for accessing the field via an interface.
+func (v *GetWellnessOfferingSourceResponse) GetModule() GetWellnessOfferingSourceModuleMarketplaceModule {
+	return v.Module
+}
+
+type InstallConsentModuleInput struct {
+	ModuleId string `json:"moduleId"`
+	Project  string `json:"project"`
+	Version  string `json:"version"`
+}
+
+// GetModuleId returns InstallConsentModuleInput.ModuleId, and is useful for accessing the field via an interface.
+func (v *InstallConsentModuleInput) GetModuleId() string { return v.ModuleId }
+
+// GetProject returns InstallConsentModuleInput.Project, and is useful for accessing the field via an interface.
+func (v *InstallConsentModuleInput) GetProject() string { return v.Project }
+
+// GetVersion returns InstallConsentModuleInput.Version, and is useful for accessing the field via an interface.
+func (v *InstallConsentModuleInput) GetVersion() string { return v.Version }
+
+// InstallConsentModuleInstallConsentModuleInstallConsentModuleResponse includes the requested fields of the GraphQL type InstallConsentModuleResponse.
+type InstallConsentModuleInstallConsentModuleInstallConsentModuleResponse struct {
+	ModuleId string `json:"moduleId"`
+}
+
+// GetModuleId returns InstallConsentModuleInstallConsentModuleInstallConsentModuleResponse.ModuleId, and is useful for accessing the field via an interface.
+func (v *InstallConsentModuleInstallConsentModuleInstallConsentModuleResponse) GetModuleId() string {
+	return v.ModuleId
+}
+
+// InstallConsentModuleResponse is returned by InstallConsentModule on success.
+type InstallConsentModuleResponse struct {
+	InstallConsentModule InstallConsentModuleInstallConsentModuleInstallConsentModuleResponse `json:"installConsentModule"`
+}
+
+// GetInstallConsentModule returns InstallConsentModuleResponse.InstallConsentModule, and is useful for accessing the field via an interface.
+func (v *InstallConsentModuleResponse) GetInstallConsentModule() InstallConsentModuleInstallConsentModuleInstallConsentModuleResponse {
+	return v.InstallConsentModule
+}
+
+type InstallDomainOntologyModuleInput struct {
+	ModuleId string `json:"moduleId"`
+	Project  string `json:"project"`
+	Version  string `json:"version"`
+}
+
+// GetModuleId returns InstallDomainOntologyModuleInput.ModuleId, and is useful for accessing the field via an interface.
+func (v *InstallDomainOntologyModuleInput) GetModuleId() string { return v.ModuleId }
+
+// GetProject returns InstallDomainOntologyModuleInput.Project, and is useful for accessing the field via an interface.
+func (v *InstallDomainOntologyModuleInput) GetProject() string { return v.Project }
+
+// GetVersion returns InstallDomainOntologyModuleInput.Version, and is useful for accessing the field via an interface.
+func (v *InstallDomainOntologyModuleInput) GetVersion() string { return v.Version }
+
+// InstallDomainOntologyModuleInstallDomainOntologyModuleInstallDomainOntologyModuleResponse includes the requested fields of the GraphQL type InstallDomainOntologyModuleResponse.
+type InstallDomainOntologyModuleInstallDomainOntologyModuleInstallDomainOntologyModuleResponse struct {
+	Id string `json:"id"`
+}
+
+// GetId returns InstallDomainOntologyModuleInstallDomainOntologyModuleInstallDomainOntologyModuleResponse.Id, and is useful for accessing the field via an interface.
+func (v *InstallDomainOntologyModuleInstallDomainOntologyModuleInstallDomainOntologyModuleResponse) GetId() string {
+	return v.Id
+}
+
+// InstallDomainOntologyModuleResponse is returned by InstallDomainOntologyModule on success.
+type InstallDomainOntologyModuleResponse struct {
+	InstallDomainOntologyModule InstallDomainOntologyModuleInstallDomainOntologyModuleInstallDomainOntologyModuleResponse `json:"installDomainOntologyModule"`
+}
+
+// GetInstallDomainOntologyModule returns InstallDomainOntologyModuleResponse.InstallDomainOntologyModule, and is useful for accessing the field via an interface.
+func (v *InstallDomainOntologyModuleResponse) GetInstallDomainOntologyModule() InstallDomainOntologyModuleInstallDomainOntologyModuleInstallDomainOntologyModuleResponse {
+	return v.InstallDomainOntologyModule
+}
+
+// InstallFields includes the GraphQL fields of Install requested by the fragment InstallFields.
+type InstallFields struct {
+	Id          string                           `json:"id"`
+	InstalledOn int64                            `json:"installedOn"`
+	Module      InstallFieldsModuleInstallModule `json:"-"`
+}
+
+// GetId returns InstallFields.Id, and is useful for accessing the field via an interface.
+func (v *InstallFields) GetId() string { return v.Id }
+
+// GetInstalledOn returns InstallFields.InstalledOn, and is useful for accessing the field via an interface.
+func (v *InstallFields) GetInstalledOn() int64 { return v.InstalledOn }
+
+// GetModule returns InstallFields.Module, and is useful for accessing the field via an interface.
+func (v *InstallFields) GetModule() InstallFieldsModuleInstallModule { return v.Module }
+
+func (v *InstallFields) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*InstallFields
+		Module json.RawMessage `json:"module"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.InstallFields = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Module
+		src := firstPass.Module
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalInstallFieldsModuleInstallModule(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"Unable to unmarshal InstallFields.Module: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalInstallFields struct {
+	Id string `json:"id"`
+
+	InstalledOn int64 `json:"installedOn"`
+
+	Module json.RawMessage `json:"module"`
+}
+
+func (v *InstallFields) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *InstallFields) __premarshalJSON() (*__premarshalInstallFields, error) {
+	var retval __premarshalInstallFields
+
+	retval.Id = v.Id
+	retval.InstalledOn = v.InstalledOn
+	{
+
+		dst := &retval.Module
+		src := v.Module
+		var err error
+		*dst, err = __marshalInstallFieldsModuleInstallModule(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"Unable to marshal InstallFields.Module: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+// InstallFieldsModuleIncorrectScopeMessage includes the requested fields of the GraphQL type IncorrectScopeMessage.
+type InstallFieldsModuleIncorrectScopeMessage struct {
+	Typename string `json:"__typename"`
+	Message  string `json:"message"`
+}
+
+// GetTypename returns InstallFieldsModuleIncorrectScopeMessage.Typename, and is useful for accessing the field via an interface.
+func (v *InstallFieldsModuleIncorrectScopeMessage) GetTypename() string { return v.Typename }
+
+// GetMessage returns InstallFieldsModuleIncorrectScopeMessage.Message, and is useful for accessing the field via an interface.
+func (v *InstallFieldsModuleIncorrectScopeMessage) GetMessage() string { return v.Message }
+
+// InstallFieldsModuleInstallModule includes the requested fields of the GraphQL interface InstallModule.
+//
+// InstallFieldsModuleInstallModule is implemented by the following types:
+// InstallFieldsModuleIncorrectScopeMessage
+// InstallFieldsModuleMarketplaceModule
+// InstallFieldsModuleModuleDeletedMessage
+type InstallFieldsModuleInstallModule interface {
+	implementsGraphQLInterfaceInstallFieldsModuleInstallModule()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() string
+}
+
+func (v *InstallFieldsModuleIncorrectScopeMessage) implementsGraphQLInterfaceInstallFieldsModuleInstallModule() {
+}
+func (v *InstallFieldsModuleMarketplaceModule) implementsGraphQLInterfaceInstallFieldsModuleInstallModule() {
+}
+func (v *InstallFieldsModuleModuleDeletedMessage) implementsGraphQLInterfaceInstallFieldsModuleInstallModule() {
+}
+
+func __unmarshalInstallFieldsModuleInstallModule(b []byte, v *InstallFieldsModuleInstallModule) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "IncorrectScopeMessage":
+		*v = new(InstallFieldsModuleIncorrectScopeMessage)
+		return json.Unmarshal(b, *v)
+	case "MarketplaceModule":
+		*v = new(InstallFieldsModuleMarketplaceModule)
+		return json.Unmarshal(b, *v)
+	case "ModuleDeletedMessage":
+		*v = new(InstallFieldsModuleModuleDeletedMessage)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing InstallModule.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for InstallFieldsModuleInstallModule: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalInstallFieldsModuleInstallModule(v *InstallFieldsModuleInstallModule) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *InstallFieldsModuleIncorrectScopeMessage:
+		typename = "IncorrectScopeMessage"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*InstallFieldsModuleIncorrectScopeMessage
+		}{typename, v}
+		return json.Marshal(result)
+	case *InstallFieldsModuleMarketplaceModule:
+		typename = "MarketplaceModule"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*InstallFieldsModuleMarketplaceModule
+		}{typename, v}
+		return json.Marshal(result)
+	case *InstallFieldsModuleModuleDeletedMessage:
+		typename = "ModuleDeletedMessage"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*InstallFieldsModuleModuleDeletedMessage
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for InstallFieldsModuleInstallModule: "%T"`, v)
+	}
+}
+
+// InstallFieldsModuleMarketplaceModule includes the requested fields of the GraphQL type MarketplaceModule.
+type InstallFieldsModuleMarketplaceModule struct {
+	Typename string         `json:"__typename"`
+	Id       string         `json:"id"`
+	Title    string         `json:"title"`
+	Version  string         `json:"version"`
+	Category ModuleCategory `json:"category"`
+}
+
+// GetTypename returns InstallFieldsModuleMarketplaceModule.Typename, and is useful for accessing the field via an interface.
+func (v *InstallFieldsModuleMarketplaceModule) GetTypename() string { return v.Typename }
+
+// GetId returns InstallFieldsModuleMarketplaceModule.Id, and is useful for accessing the field via an interface.
+func (v *InstallFieldsModuleMarketplaceModule) GetId() string { return v.Id }
+
+// GetTitle returns InstallFieldsModuleMarketplaceModule.Title, and is useful for accessing the field via an interface.
+func (v *InstallFieldsModuleMarketplaceModule) GetTitle() string { return v.Title }
+
+// GetVersion returns InstallFieldsModuleMarketplaceModule.Version, and is useful for accessing the field via an interface.
+func (v *InstallFieldsModuleMarketplaceModule) GetVersion() string { return v.Version }
+
+// GetCategory returns InstallFieldsModuleMarketplaceModule.Category, and is useful for accessing the field via an interface.
+func (v *InstallFieldsModuleMarketplaceModule) GetCategory() ModuleCategory { return v.Category }
+
+// InstallFieldsModuleModuleDeletedMessage includes the requested fields of the GraphQL type ModuleDeletedMessage.
+type InstallFieldsModuleModuleDeletedMessage struct {
+	Typename string `json:"__typename"`
+	ModuleId string `json:"moduleId"`
+	Message  string `json:"message"`
+}
+
+// GetTypename returns InstallFieldsModuleModuleDeletedMessage.Typename, and is useful for accessing the field via an interface.
+func (v *InstallFieldsModuleModuleDeletedMessage) GetTypename() string { return v.Typename }
+
+// GetModuleId returns InstallFieldsModuleModuleDeletedMessage.ModuleId, and is useful for accessing the field via an interface.
+func (v *InstallFieldsModuleModuleDeletedMessage) GetModuleId() string { return v.ModuleId }
+
+// GetMessage returns InstallFieldsModuleModuleDeletedMessage.Message, and is useful for accessing the field via an interface.
+func (v *InstallFieldsModuleModuleDeletedMessage) GetMessage() string { return v.Message }
+
+type InstallInsightsLayoutModuleInput struct {
+	ModuleId string `json:"moduleId"`
+	Project  string `json:"project"`
+	Version  string `json:"version"`
+}
+
+// GetModuleId returns InstallInsightsLayoutModuleInput.ModuleId, and is useful for accessing the field via an interface.
+func (v *InstallInsightsLayoutModuleInput) GetModuleId() string { return v.ModuleId }
+
+// GetProject returns InstallInsightsLayoutModuleInput.Project, and is useful for accessing the field via an interface.
+func (v *InstallInsightsLayoutModuleInput) GetProject() string { return v.Project }
+
+// GetVersion returns InstallInsightsLayoutModuleInput.Version, and is useful for accessing the field via an interface.
+func (v *InstallInsightsLayoutModuleInput) GetVersion() string { return v.Version }
+
+// InstallInsightsLayoutModuleInstallInsightsLayoutModuleInstallInsightsLayoutModuleResponse includes the requested fields of the GraphQL type InstallInsightsLayoutModuleResponse.
+type InstallInsightsLayoutModuleInstallInsightsLayoutModuleInstallInsightsLayoutModuleResponse struct {
+	ModuleId string `json:"moduleId"`
+}
+
+// GetModuleId returns InstallInsightsLayoutModuleInstallInsightsLayoutModuleInstallInsightsLayoutModuleResponse.ModuleId, and is useful for accessing the field via an interface.
+func (v *InstallInsightsLayoutModuleInstallInsightsLayoutModuleInstallInsightsLayoutModuleResponse) GetModuleId() string {
+	return v.ModuleId
+}
+
+// InstallInsightsLayoutModuleResponse is returned by InstallInsightsLayoutModule on success.
+type InstallInsightsLayoutModuleResponse struct {
+	InstallInsightsLayoutModule InstallInsightsLayoutModuleInstallInsightsLayoutModuleInstallInsightsLayoutModuleResponse `json:"installInsightsLayoutModule"`
+}
+
+// GetInstallInsightsLayoutModule returns InstallInsightsLayoutModuleResponse.InstallInsightsLayoutModule, and is useful for accessing the field via an interface.
+func (v *InstallInsightsLayoutModuleResponse) GetInstallInsightsLayoutModule() InstallInsightsLayoutModuleInstallInsightsLayoutModuleInstallInsightsLayoutModuleResponse {
+	return v.InstallInsightsLayoutModule
+}
+
+type InstallNotebookModuleInput struct {
+	ModuleId string `json:"moduleId"`
+	Version  string `json:"version"`
+}
+
+// GetModuleId returns InstallNotebookModuleInput.ModuleId, and is useful for accessing the field via an interface.
+func (v *InstallNotebookModuleInput) GetModuleId() string { return v.ModuleId }
+
+// GetVersion returns InstallNotebookModuleInput.Version, and is useful for accessing the field via an interface.
+func (v *InstallNotebookModuleInput) GetVersion() string { return v.Version }
+
+// InstallNotebookModuleInstallNotebookModuleInstallNotebookModuleResponse includes the requested fields of the GraphQL type InstallNotebookModuleResponse.
+type InstallNotebookModuleInstallNotebookModuleInstallNotebookModuleResponse struct {
+	ModuleId string `json:"moduleId"`
+}
+
+// GetModuleId returns InstallNotebookModuleInstallNotebookModuleInstallNotebookModuleResponse.ModuleId, and is useful for accessing the field via an interface.
+func (v *InstallNotebookModuleInstallNotebookModuleInstallNotebookModuleResponse) GetModuleId() string {
+	return v.ModuleId
+}
+
+// InstallNotebookModuleResponse is returned by InstallNotebookModule on success.
+type InstallNotebookModuleResponse struct {
+	InstallNotebookModule InstallNotebookModuleInstallNotebookModuleInstallNotebookModuleResponse `json:"installNotebookModule"`
+}
+
+// GetInstallNotebookModule returns InstallNotebookModuleResponse.InstallNotebookModule, and is useful for accessing the field via an interface.
+func (v *InstallNotebookModuleResponse) GetInstallNotebookModule() InstallNotebookModuleInstallNotebookModuleInstallNotebookModuleResponse {
+	return v.InstallNotebookModule
+}
+
+// InstallPage includes the GraphQL fields of InstallConnection requested by the fragment InstallPage.
+type InstallPage struct {
+	Edges    []InstallPageEdgesInstallEdge `json:"edges"`
+	PageInfo InstallPagePageInfo           `json:"pageInfo"`
+}
+
+// GetEdges returns InstallPage.Edges, and is useful for accessing the field via an interface.
+func (v *InstallPage) GetEdges() []InstallPageEdgesInstallEdge { return v.Edges }
+
+// GetPageInfo returns InstallPage.PageInfo, and is useful for accessing the field via an interface.
+func (v *InstallPage) GetPageInfo() InstallPagePageInfo { return v.PageInfo }
+
+// InstallPageEdgesInstallEdge includes the requested fields of the GraphQL type InstallEdge.
+type InstallPageEdgesInstallEdge struct {
+	Node InstallPageEdgesInstallEdgeNodeInstall `json:"node"`
+}
+
+// GetNode returns InstallPageEdgesInstallEdge.Node, and is useful for accessing the field via an interface.
+func (v *InstallPageEdgesInstallEdge) GetNode() InstallPageEdgesInstallEdgeNodeInstall { return v.Node }
+
+// InstallPageEdgesInstallEdgeNodeInstall includes the requested fields of the GraphQL type Install.
+type InstallPageEdgesInstallEdgeNodeInstall struct {
+	InstallFields `json:"-"`
+}
+
+// GetId returns InstallPageEdgesInstallEdgeNodeInstall.Id, and is useful for accessing the field via an interface.
+func (v *InstallPageEdgesInstallEdgeNodeInstall) GetId() string { return v.InstallFields.Id }
+
+// GetInstalledOn returns InstallPageEdgesInstallEdgeNodeInstall.InstalledOn, and is useful for accessing the field via an interface.
+func (v *InstallPageEdgesInstallEdgeNodeInstall) GetInstalledOn() int64 {
+	return v.InstallFields.InstalledOn
+}
+
+// GetModule returns InstallPageEdgesInstallEdgeNodeInstall.Module, and is useful for accessing the field via an interface.
+func (v *InstallPageEdgesInstallEdgeNodeInstall) GetModule() InstallFieldsModuleInstallModule {
+	return v.InstallFields.Module
+}
+
+func (v *InstallPageEdgesInstallEdgeNodeInstall) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*InstallPageEdgesInstallEdgeNodeInstall
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.InstallPageEdgesInstallEdgeNodeInstall = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.InstallFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalInstallPageEdgesInstallEdgeNodeInstall struct {
+	Id string `json:"id"`
+
+	InstalledOn int64 `json:"installedOn"`
+
+	Module json.RawMessage `json:"module"`
+}
+
+func (v *InstallPageEdgesInstallEdgeNodeInstall) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *InstallPageEdgesInstallEdgeNodeInstall) __premarshalJSON() (*__premarshalInstallPageEdgesInstallEdgeNodeInstall, error) {
+	var retval __premarshalInstallPageEdgesInstallEdgeNodeInstall
+
+	retval.Id = v.InstallFields.Id
+	retval.InstalledOn = v.InstallFields.InstalledOn
+	{
+
+		dst := &retval.Module
+		src := v.InstallFields.Module
+		var err error
+		*dst, err = __marshalInstallFieldsModuleInstallModule(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"Unable to marshal InstallPageEdgesInstallEdgeNodeInstall.InstallFields.Module: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+// InstallPagePageInfo includes the requested fields of the GraphQL type PageInfo.
+type InstallPagePageInfo struct {
+	EndCursor   string `json:"endCursor"`
+	HasNextPage bool   `json:"hasNextPage"`
+}
+
+// GetEndCursor returns InstallPagePageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *InstallPagePageInfo) GetEndCursor() string { return v.EndCursor }
+
+// GetHasNextPage returns InstallPagePageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *InstallPagePageInfo) GetHasNextPage() bool { return v.HasNextPage }
+
+type InstallPatientLayoutModuleInput struct {
+	ModuleId string `json:"moduleId"`
+	Project  string `json:"project"`
+	Version  string `json:"version"`
+}
+
+// GetModuleId returns InstallPatientLayoutModuleInput.ModuleId, and is useful for accessing the field via an interface.
+func (v *InstallPatientLayoutModuleInput) GetModuleId() string { return v.ModuleId }
+
+// GetProject returns InstallPatientLayoutModuleInput.Project, and is useful for accessing the field via an interface.
+func (v *InstallPatientLayoutModuleInput) GetProject() string { return v.Project }
+
+// GetVersion returns InstallPatientLayoutModuleInput.Version, and is useful for accessing the field via an interface.
+func (v *InstallPatientLayoutModuleInput) GetVersion() string { return v.Version }
+
+// InstallPatientLayoutModuleInstallPatientLayoutModuleInstallPatientLayoutModuleResponse includes the requested fields of the GraphQL type InstallPatientLayoutModuleResponse.
+type InstallPatientLayoutModuleInstallPatientLayoutModuleInstallPatientLayoutModuleResponse struct {
+	ModuleId string `json:"moduleId"`
+}
+
+// GetModuleId returns InstallPatientLayoutModuleInstallPatientLayoutModuleInstallPatientLayoutModuleResponse.ModuleId, and is useful for accessing the field via an interface.
+func (v *InstallPatientLayoutModuleInstallPatientLayoutModuleInstallPatientLayoutModuleResponse) GetModuleId() string {
+	return v.ModuleId
+}
+
+// InstallPatientLayoutModuleResponse is returned by InstallPatientLayoutModule on success.
+type InstallPatientLayoutModuleResponse struct {
+	InstallPatientLayoutModule InstallPatientLayoutModuleInstallPatientLayoutModuleInstallPatientLayoutModuleResponse `json:"installPatientLayoutModule"`
+}
+
+// GetInstallPatientLayoutModule returns InstallPatientLayoutModuleResponse.InstallPatientLayoutModule, and is useful for accessing the field via an interface.
+func (v *InstallPatientLayoutModuleResponse) GetInstallPatientLayoutModule() InstallPatientLayoutModuleInstallPatientLayoutModuleInstallPatientLayoutModuleResponse {
+	return v.InstallPatientLayoutModule
+}
+
+type InstallProcessOntologyModuleInput struct {
+	ModuleId string `json:"moduleId"`
+	Project  string `json:"project"`
+	Version  string `json:"version"`
+}
+
+// GetModuleId returns InstallProcessOntologyModuleInput.ModuleId, and is useful for accessing the field via an interface.
+func (v *InstallProcessOntologyModuleInput) GetModuleId() string { return v.ModuleId }
+
+// GetProject returns InstallProcessOntologyModuleInput.Project, and is useful for accessing the field via an interface.
+func (v *InstallProcessOntologyModuleInput) GetProject() string { return v.Project }
+
+// GetVersion returns InstallProcessOntologyModuleInput.Version, and is useful for accessing the field via an interface.
+func (v *InstallProcessOntologyModuleInput) GetVersion() string { return v.Version }
+
+// InstallProcessOntologyModuleInstallProcessOntologyModuleInstallProcessOntologyModuleResponse includes the requested fields of the GraphQL type InstallProcessOntologyModuleResponse.
+type InstallProcessOntologyModuleInstallProcessOntologyModuleInstallProcessOntologyModuleResponse struct {
+	Id string `json:"id"`
+}
+
+// GetId returns InstallProcessOntologyModuleInstallProcessOntologyModuleInstallProcessOntologyModuleResponse.Id, and is useful for accessing the field via an interface.
+func (v *InstallProcessOntologyModuleInstallProcessOntologyModuleInstallProcessOntologyModuleResponse) GetId() string {
+	return v.Id
+}
+
+// InstallProcessOntologyModuleResponse is returned by InstallProcessOntologyModule on success.
+type InstallProcessOntologyModuleResponse struct {
+	InstallProcessOntologyModule InstallProcessOntologyModuleInstallProcessOntologyModuleInstallProcessOntologyModuleResponse `json:"installProcessOntologyModule"`
+}
+
+// GetInstallProcessOntologyModule returns InstallProcessOntologyModuleResponse.InstallProcessOntologyModule, and is useful for accessing the field via an interface.
+func (v *InstallProcessOntologyModuleResponse) GetInstallProcessOntologyModule() InstallProcessOntologyModuleInstallProcessOntologyModuleInstallProcessOntologyModuleResponse {
+	return v.InstallProcessOntologyModule
+}
+
+type InstallProgramEnrollmentModuleInput struct {
+	EnrollmentScheduledTime string `json:"enrollmentScheduledTime,omitempty"`
+	ModuleId                string `json:"moduleId"`
+	Version                 string `json:"version"`
+}
+
+// GetEnrollmentScheduledTime returns InstallProgramEnrollmentModuleInput.EnrollmentScheduledTime, and is useful for accessing the field via an interface.
+func (v *InstallProgramEnrollmentModuleInput) GetEnrollmentScheduledTime() string {
+	return v.EnrollmentScheduledTime
+}
+
+// GetModuleId returns InstallProgramEnrollmentModuleInput.ModuleId, and is useful for accessing the field via an interface.
+func (v *InstallProgramEnrollmentModuleInput) GetModuleId() string { return v.ModuleId }
+
+// GetVersion returns InstallProgramEnrollmentModuleInput.Version, and is useful for accessing the field via an interface.
+func (v *InstallProgramEnrollmentModuleInput) GetVersion() string { return v.Version }
+
+// InstallProgramEnrollmentModuleInstallProgramEnrollmentModuleInstallProgramEnrollmentModuleResponse includes the requested fields of the GraphQL type InstallProgramEnrollmentModuleResponse.
+type InstallProgramEnrollmentModuleInstallProgramEnrollmentModuleInstallProgramEnrollmentModuleResponse struct {
+	ModuleId string `json:"moduleId"`
+}
+
+// GetModuleId returns InstallProgramEnrollmentModuleInstallProgramEnrollmentModuleInstallProgramEnrollmentModuleResponse.ModuleId, and is useful for accessing the field via an interface.
+func (v *InstallProgramEnrollmentModuleInstallProgramEnrollmentModuleInstallProgramEnrollmentModuleResponse) GetModuleId() string {
+	return v.ModuleId
+}
+
+// InstallProgramEnrollmentModuleResponse is returned by InstallProgramEnrollmentModule on success.
+type InstallProgramEnrollmentModuleResponse struct {
+	InstallProgramEnrollmentModule InstallProgramEnrollmentModuleInstallProgramEnrollmentModuleInstallProgramEnrollmentModuleResponse `json:"installProgramEnrollmentModule"`
+}
+
+// GetInstallProgramEnrollmentModule returns InstallProgramEnrollmentModuleResponse.InstallProgramEnrollmentModule, and is useful for accessing the field via an interface.
+func (v *InstallProgramEnrollmentModuleResponse) GetInstallProgramEnrollmentModule() InstallProgramEnrollmentModuleInstallProgramEnrollmentModuleInstallProgramEnrollmentModuleResponse {
+	return v.InstallProgramEnrollmentModule
+}
+
+type InstallProgramTemplateModuleInput struct {
+	ModuleId string `json:"moduleId"`
+	Project  string `json:"project"`
+	Version  string `json:"version"`
+}
+
+// GetModuleId returns InstallProgramTemplateModuleInput.ModuleId, and is useful for accessing the field via an interface.
+func (v *InstallProgramTemplateModuleInput) GetModuleId() string { return v.ModuleId }
+
+// GetProject returns InstallProgramTemplateModuleInput.Project, and is useful for accessing the field via an interface.
+func (v *InstallProgramTemplateModuleInput) GetProject() string { return v.Project }
+
+// GetVersion returns InstallProgramTemplateModuleInput.Version, and is useful for accessing the field via an interface.
+func (v *InstallProgramTemplateModuleInput) GetVersion() string { return v.Version }
+
+// InstallProgramTemplateModuleInstallProgramTemplateModuleInstallProgramTemplateModuleResponse includes the requested fields of the GraphQL type InstallProgramTemplateModuleResponse.
+type InstallProgramTemplateModuleInstallProgramTemplateModuleInstallProgramTemplateModuleResponse struct {
+	ModuleId string `json:"moduleId"`
+}
+
+// GetModuleId returns InstallProgramTemplateModuleInstallProgramTemplateModuleInstallProgramTemplateModuleResponse.ModuleId, and is useful for accessing the field via an interface.
+func (v *InstallProgramTemplateModuleInstallProgramTemplateModuleInstallProgramTemplateModuleResponse) GetModuleId() string {
+	return v.ModuleId
+}
+
+// InstallProgramTemplateModuleResponse is returned by InstallProgramTemplateModule on success.
+type InstallProgramTemplateModuleResponse struct {
+	InstallProgramTemplateModule InstallProgramTemplateModuleInstallProgramTemplateModuleInstallProgramTemplateModuleResponse `json:"installProgramTemplateModule"`
+}
+
+// GetInstallProgramTemplateModule returns InstallProgramTemplateModuleResponse.InstallProgramTemplateModule, and is useful for accessing the field via an interface.
+func (v *InstallProgramTemplateModuleResponse) GetInstallProgramTemplateModule() InstallProgramTemplateModuleInstallProgramTemplateModuleInstallProgramTemplateModuleResponse {
+	return v.InstallProgramTemplateModule
+}
+
+type InstallPublicAppTileModuleInput struct {
+	ModuleId string `json:"moduleId"`
+	Version  string `json:"version"`
+}
+
+// GetModuleId returns InstallPublicAppTileModuleInput.ModuleId, and is useful for accessing the field via an interface.
+func (v *InstallPublicAppTileModuleInput) GetModuleId() string { return v.ModuleId }
+
+// GetVersion returns InstallPublicAppTileModuleInput.Version, and is useful for accessing the field via an interface.
+func (v *InstallPublicAppTileModuleInput) GetVersion() string { return v.Version }
+
+// InstallPublicAppTileModuleInstallPublicAppTileModuleInstallPublicAppTileModuleResponse includes the requested fields of the GraphQL type InstallPublicAppTileModuleResponse.
+type InstallPublicAppTileModuleInstallPublicAppTileModuleInstallPublicAppTileModuleResponse struct {
+	AppTileId string `json:"appTileId"`
+	ModuleId  string `json:"moduleId"`
+}
+
+// GetAppTileId returns InstallPublicAppTileModuleInstallPublicAppTileModuleInstallPublicAppTileModuleResponse.AppTileId, and is useful for accessing the field via an interface.
+func (v *InstallPublicAppTileModuleInstallPublicAppTileModuleInstallPublicAppTileModuleResponse) GetAppTileId() string {
+	return v.AppTileId
+}
+
+// GetModuleId returns InstallPublicAppTileModuleInstallPublicAppTileModuleInstallPublicAppTileModuleResponse.ModuleId, and is useful for accessing the field via an interface.
+func (v *InstallPublicAppTileModuleInstallPublicAppTileModuleInstallPublicAppTileModuleResponse) GetModuleId() string {
+	return v.ModuleId
+}
+
+// InstallPublicAppTileModuleResponse is returned by InstallPublicAppTileModule on success.
+type InstallPublicAppTileModuleResponse struct {
+	InstallPublicAppTileModule InstallPublicAppTileModuleInstallPublicAppTileModuleInstallPublicAppTileModuleResponse `json:"installPublicAppTileModule"`
+}
+
+// GetInstallPublicAppTileModule returns InstallPublicAppTileModuleResponse.InstallPublicAppTileModule, and is useful for accessing the field via an interface.
+func (v *InstallPublicAppTileModuleResponse) GetInstallPublicAppTileModule() InstallPublicAppTileModuleInstallPublicAppTileModuleInstallPublicAppTileModuleResponse {
+	return v.InstallPublicAppTileModule
+}
+
+type InstallReportExtractorModuleInput struct {
+	ModuleId string `json:"moduleId"`
+	Project  string `json:"project"`
+	Version  string `json:"version"`
+}
+
+// GetModuleId returns InstallReportExtractorModuleInput.ModuleId, and is useful for accessing the field via an interface.
+func (v *InstallReportExtractorModuleInput) GetModuleId() string { return v.ModuleId }
+
+// GetProject returns InstallReportExtractorModuleInput.Project, and is useful for accessing the field via an interface.
+func (v *InstallReportExtractorModuleInput) GetProject() string { return v.Project }
+
+// GetVersion returns InstallReportExtractorModuleInput.Version, and is useful for accessing the field via an interface.
+func (v *InstallReportExtractorModuleInput) GetVersion() string { return v.Version }
+
+// InstallReportExtractorModuleInstallReportExtractorModuleInstallReportExtractorModuleResponse includes the requested fields of the GraphQL type InstallReportExtractorModuleResponse.
+type InstallReportExtractorModuleInstallReportExtractorModuleInstallReportExtractorModuleResponse struct {
+	ModuleId string `json:"moduleId"`
+}
+
+// GetModuleId returns InstallReportExtractorModuleInstallReportExtractorModuleInstallReportExtractorModuleResponse.ModuleId, and is useful for accessing the field via an interface.
+func (v *InstallReportExtractorModuleInstallReportExtractorModuleInstallReportExtractorModuleResponse) GetModuleId() string {
+	return v.ModuleId
+}
+
+// InstallReportExtractorModuleResponse is returned by InstallReportExtractorModule on success.
+type InstallReportExtractorModuleResponse struct {
+	InstallReportExtractorModule InstallReportExtractorModuleInstallReportExtractorModuleInstallReportExtractorModuleResponse `json:"installReportExtractorModule"`
+}
+
+// GetInstallReportExtractorModule returns InstallReportExtractorModuleResponse.InstallReportExtractorModule, and is useful for accessing the field via an interface.
+func (v *InstallReportExtractorModuleResponse) GetInstallReportExtractorModule() InstallReportExtractorModuleInstallReportExtractorModuleInstallReportExtractorModuleResponse {
+	return v.InstallReportExtractorModule
+}
+
+type InstallSearchLayoutModuleInput struct {
+	ModuleId string `json:"moduleId"`
+	Project  string `json:"project"`
+	Version  string `json:"version"`
+}
+
+// GetModuleId returns InstallSearchLayoutModuleInput.ModuleId, and is useful for accessing the field via an interface.
+func (v *InstallSearchLayoutModuleInput) GetModuleId() string { return v.ModuleId }
+
+// GetProject returns InstallSearchLayoutModuleInput.Project, and is useful for accessing the field via an interface.
+func (v *InstallSearchLayoutModuleInput) GetProject() string { return v.Project }
+
+// GetVersion returns InstallSearchLayoutModuleInput.Version, and is useful for accessing the field via an interface.
+func (v *InstallSearchLayoutModuleInput) GetVersion() string { return v.Version }
+
+// InstallSearchLayoutModuleInstallSearchLayoutModuleInstallSearchLayoutModuleResponse includes the requested fields of the GraphQL type InstallSearchLayoutModuleResponse.
+type InstallSearchLayoutModuleInstallSearchLayoutModuleInstallSearchLayoutModuleResponse struct {
+	ModuleId string `json:"moduleId"`
+}
+
+// GetModuleId returns InstallSearchLayoutModuleInstallSearchLayoutModuleInstallSearchLayoutModuleResponse.ModuleId, and is useful for accessing the field via an interface.
+func (v *InstallSearchLayoutModuleInstallSearchLayoutModuleInstallSearchLayoutModuleResponse) GetModuleId() string {
+	return v.ModuleId
+}
+
+// InstallSearchLayoutModuleResponse is returned by InstallSearchLayoutModule on success.
+type InstallSearchLayoutModuleResponse struct {
+	InstallSearchLayoutModule InstallSearchLayoutModuleInstallSearchLayoutModuleInstallSearchLayoutModuleResponse `json:"installSearchLayoutModule"`
+}
+
+// GetInstallSearchLayoutModule returns InstallSearchLayoutModuleResponse.InstallSearchLayoutModule, and is useful for accessing the field via an interface.
+func (v *InstallSearchLayoutModuleResponse) GetInstallSearchLayoutModule() InstallSearchLayoutModuleInstallSearchLayoutModuleInstallSearchLayoutModuleResponse {
+	return v.InstallSearchLayoutModule
+}
+
+type InstallSurveyModuleInput struct {
+	ModuleId      string `json:"moduleId"`
+	Project       string `json:"project"`
+	SurveyVersion string `json:"surveyVersion,omitempty"`
+	Version       string `json:"version"`
+}
+
+// GetModuleId returns InstallSurveyModuleInput.ModuleId, and is useful for accessing the field via an interface.
+func (v *InstallSurveyModuleInput) GetModuleId() string { return v.ModuleId }
+
+// GetProject returns InstallSurveyModuleInput.Project, and is useful for accessing the field via an interface.
+func (v *InstallSurveyModuleInput) GetProject() string { return v.Project }
+
+// GetSurveyVersion returns InstallSurveyModuleInput.SurveyVersion, and is useful for accessing the field via an interface.
+func (v *InstallSurveyModuleInput) GetSurveyVersion() string { return v.SurveyVersion }
+
+// GetVersion returns InstallSurveyModuleInput.Version, and is useful for accessing the field via an interface.
+func (v *InstallSurveyModuleInput) GetVersion() string { return v.Version }
+
+// InstallSurveyModuleInstallSurveyModuleInstallSurveyModuleResponse includes the requested fields of the GraphQL type InstallSurveyModuleResponse.
+type InstallSurveyModuleInstallSurveyModuleInstallSurveyModuleResponse struct {
+	ModuleId string `json:"moduleId"`
+}
+
+// GetModuleId returns InstallSurveyModuleInstallSurveyModuleInstallSurveyModuleResponse.ModuleId, and is useful for accessing the field via an interface.
+func (v *InstallSurveyModuleInstallSurveyModuleInstallSurveyModuleResponse) GetModuleId() string {
+	return v.ModuleId
+}
+
+// InstallSurveyModuleResponse is returned by InstallSurveyModule on success.
+type InstallSurveyModuleResponse struct {
+	InstallSurveyModule InstallSurveyModuleInstallSurveyModuleInstallSurveyModuleResponse `json:"installSurveyModule"`
+}
+
+// GetInstallSurveyModule returns InstallSurveyModuleResponse.InstallSurveyModule, and is useful for accessing the field via an interface.
+func (v *InstallSurveyModuleResponse) GetInstallSurveyModule() InstallSurveyModuleInstallSurveyModuleInstallSurveyModuleResponse {
+	return v.InstallSurveyModule
+}
+
+type InstallWellnessOfferingModuleInput struct {
+	// The configuration to install for this offering, as a JSON blob.
+	Configuration string `json:"configuration"`
+	// Whether the offering should be enabled.
+	Enabled bool `json:"enabled"`
+	// The target engagement percentage for employee redemption
+	EngagementTarget int    `json:"engagementTarget"`
+	ModuleId         string `json:"moduleId"`
+	// The amount the employeer is subsidizing for the offering, in USD Pennies.
+	SubsidyAmount int `json:"subsidyAmount"`
+	// The frequency by which redemption rules are applied to employees
+	SubsidyPeriod Period `json:"subsidyPeriod"`
+	Version       string `json:"version"`
+}
+
+// GetConfiguration returns InstallWellnessOfferingModuleInput.Configuration, and is useful for accessing the field via an interface.
+func (v *InstallWellnessOfferingModuleInput) GetConfiguration() string { return v.Configuration }
+
+// GetEnabled returns InstallWellnessOfferingModuleInput.Enabled, and is useful for accessing the field via an interface.
+func (v *InstallWellnessOfferingModuleInput) GetEnabled() bool { return v.Enabled }
+
+// GetEngagementTarget returns InstallWellnessOfferingModuleInput.EngagementTarget, and is useful for accessing the field via an interface.
+func (v *InstallWellnessOfferingModuleInput) GetEngagementTarget() int { return v.EngagementTarget }
+
+// GetModuleId returns InstallWellnessOfferingModuleInput.ModuleId, and is useful for accessing the field via an interface.
+func (v *InstallWellnessOfferingModuleInput) GetModuleId() string { return v.ModuleId }
+
+// GetSubsidyAmount returns InstallWellnessOfferingModuleInput.SubsidyAmount, and is useful for accessing the field via an interface.
+func (v *InstallWellnessOfferingModuleInput) GetSubsidyAmount() int { return v.SubsidyAmount }
+
+// GetSubsidyPeriod returns InstallWellnessOfferingModuleInput.SubsidyPeriod, and is useful for accessing the field via an interface.
+func (v *InstallWellnessOfferingModuleInput) GetSubsidyPeriod() Period { return v.SubsidyPeriod }
+
+// GetVersion returns InstallWellnessOfferingModuleInput.Version, and is useful for accessing the field via an interface.
+func (v *InstallWellnessOfferingModuleInput) GetVersion() string { return v.Version }
+
+// InstallWellnessOfferingModuleInstallWellnessOfferingModuleInstallWellnessOfferingModuleResponse includes the requested fields of the GraphQL type InstallWellnessOfferingModuleResponse.
+type InstallWellnessOfferingModuleInstallWellnessOfferingModuleInstallWellnessOfferingModuleResponse struct {
+	Id      string `json:"id"`
+	Version string `json:"version"`
+}
+
+// GetId returns InstallWellnessOfferingModuleInstallWellnessOfferingModuleInstallWellnessOfferingModuleResponse.Id, and is useful for accessing the field via an interface.
+func (v *InstallWellnessOfferingModuleInstallWellnessOfferingModuleInstallWellnessOfferingModuleResponse) GetId() string {
+	return v.Id
+}
+
+// GetVersion returns InstallWellnessOfferingModuleInstallWellnessOfferingModuleInstallWellnessOfferingModuleResponse.Version, and is useful for accessing the field via an interface.
+func (v *InstallWellnessOfferingModuleInstallWellnessOfferingModuleInstallWellnessOfferingModuleResponse) GetVersion() string {
+	return v.Version
+}
+
+// InstallWellnessOfferingModuleResponse is returned by InstallWellnessOfferingModule on success.
+type InstallWellnessOfferingModuleResponse struct {
+	InstallWellnessOfferingModule InstallWellnessOfferingModuleInstallWellnessOfferingModuleInstallWellnessOfferingModuleResponse `json:"installWellnessOfferingModule"`
+}
+
+// GetInstallWellnessOfferingModule returns InstallWellnessOfferingModuleResponse.InstallWellnessOfferingModule, and is useful for accessing the field via an interface.
+func (v *InstallWellnessOfferingModuleResponse) GetInstallWellnessOfferingModule() InstallWellnessOfferingModuleInstallWellnessOfferingModuleInstallWellnessOfferingModuleResponse {
+	return v.InstallWellnessOfferingModule
+}
+
+type InstallWorkflowModuleInput struct {
+	ModuleId string `json:"moduleId"`
+	Version  string `json:"version"`
+}
+
+// GetModuleId returns InstallWorkflowModuleInput.ModuleId, and is useful for accessing the field via an interface.
+func (v *InstallWorkflowModuleInput) GetModuleId() string { return v.ModuleId }
+
+// GetVersion returns InstallWorkflowModuleInput.Version, and is useful for accessing the field via an interface.
+func (v *InstallWorkflowModuleInput) GetVersion() string { return v.Version }
+
+// InstallWorkflowModuleInstallWorkflowModuleInstallWorkflowModuleResponse includes the requested fields of the GraphQL type InstallWorkflowModuleResponse.
+type InstallWorkflowModuleInstallWorkflowModuleInstallWorkflowModuleResponse struct {
+	ModuleId string `json:"moduleId"`
+}
+
+// GetModuleId returns InstallWorkflowModuleInstallWorkflowModuleInstallWorkflowModuleResponse.ModuleId, and is useful for accessing the field via an interface.
+func (v *InstallWorkflowModuleInstallWorkflowModuleInstallWorkflowModuleResponse) GetModuleId() string {
+	return v.ModuleId
+}
+
+// InstallWorkflowModuleResponse is returned by InstallWorkflowModule on success.
+type InstallWorkflowModuleResponse struct {
+	InstallWorkflowModule InstallWorkflowModuleInstallWorkflowModuleInstallWorkflowModuleResponse `json:"installWorkflowModule"`
+}
+
+// GetInstallWorkflowModule returns InstallWorkflowModuleResponse.InstallWorkflowModule, and is useful for accessing the field via an interface.
+func (v *InstallWorkflowModuleResponse) GetInstallWorkflowModule() InstallWorkflowModuleInstallWorkflowModuleInstallWorkflowModuleResponse {
+	return v.InstallWorkflowModule
+}
+
+type InstallsInput struct {
+	ModuleId      string `json:"moduleId,omitempty"`
+	ModuleVersion string `json:"moduleVersion,omitempty"`
+}
+
+// GetModuleId returns InstallsInput.ModuleId, and is useful for accessing the field via an interface.
+func (v *InstallsInput) GetModuleId() string { return v.ModuleId }
+
+// GetModuleVersion returns InstallsInput.ModuleVersion, and is useful for accessing the field via an interface.
+func (v *InstallsInput) GetModuleVersion() string { return v.ModuleVersion }
+
+type LicenseDetailsInput struct {
+	Message string `json:"message"`
+	Url     string `json:"url"`
+}
+
+// GetMessage returns LicenseDetailsInput.Message, and is useful for accessing the field via an interface.
+func (v *LicenseDetailsInput) GetMessage() string { return v.Message }
+
+// GetUrl returns LicenseDetailsInput.Url, and is useful for accessing the field via an interface.
+func (v *LicenseDetailsInput) GetUrl() string { return v.Url }
+
+type MarketplaceModuleScope string
+
+const (
+	MarketplaceModuleScopeLicensed     MarketplaceModuleScope = "LICENSED"
+	MarketplaceModuleScopeOrganization MarketplaceModuleScope = "ORGANIZATION"
+	MarketplaceModuleScopePublic       MarketplaceModuleScope = "PUBLIC"
+)
+
+type ModuleCategory string
+
+const (
+	ModuleCategoryAppTile             ModuleCategory = "APP_TILE"
+	ModuleCategoryConsent             ModuleCategory = "CONSENT"
+	ModuleCategoryDomainOntology      ModuleCategory = "DOMAIN_ONTOLOGY"
+	ModuleCategoryInsightsLayout      ModuleCategory = "INSIGHTS_LAYOUT"
+	ModuleCategoryNotebook            ModuleCategory = "NOTEBOOK"
+	ModuleCategoryPatientViewerLayout ModuleCategory = "PATIENT_VIEWER_LAYOUT"
+	ModuleCategoryProcessOntology     ModuleCategory = "PROCESS_ONTOLOGY"
+	ModuleCategoryProgramEnrollment   ModuleCategory = "PROGRAM_ENROLLMENT"
+	ModuleCategoryProgramTemplate     ModuleCategory = "PROGRAM_TEMPLATE"
+	ModuleCategoryReportExtractor     ModuleCategory = "REPORT_EXTRACTOR"
+	ModuleCategorySearchLayout        ModuleCategory = "SEARCH_LAYOUT"
+	ModuleCategorySurvey              ModuleCategory = "SURVEY"
+	ModuleCategoryWellnessOffering    ModuleCategory = "WELLNESS_OFFERING"
+	ModuleCategoryWorkflow            ModuleCategory = "WORKFLOW"
+)
+
+type ModuleProduct string
+
+const (
+	ModuleProductLifeology         ModuleProduct = "LIFEOLOGY"
+	ModuleProductLifeExtendApp     ModuleProduct = "LIFE_EXTEND_APP"
+	ModuleProductLifeFastingApp    ModuleProduct = "LIFE_FASTING_APP"
+	ModuleProductLifeMobileApps    ModuleProduct = "LIFE_MOBILE_APPS"
+	ModuleProductOcr               ModuleProduct = "OCR"
+	ModuleProductPhc               ModuleProduct = "PHC"
+	ModuleProductPrecisionOutcomes ModuleProduct = "PRECISION_OUTCOMES"
+	ModuleProductPrecisionWellness ModuleProduct = "PRECISION_WELLNESS"
+	ModuleProductSkillspring       ModuleProduct = "SKILLSPRING"
+)
+
+type ModuleReviewStatus string
+
+const (
+	ModuleReviewStatusApproved        ModuleReviewStatus = "APPROVED"
+	ModuleReviewStatusCanceled        ModuleReviewStatus = "CANCELED"
+	ModuleReviewStatusDenied          ModuleReviewStatus = "DENIED"
+	ModuleReviewStatusInitialApproval ModuleReviewStatus = "INITIAL_APPROVAL"
+	ModuleReviewStatusNew             ModuleReviewStatus = "NEW"
+)
+
+type ModuleStatsDateRangeInput struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+// GetFrom returns ModuleStatsDateRangeInput.From, and is useful for accessing the field via an interface.
+func (v *ModuleStatsDateRangeInput) GetFrom() string { return v.From }
+
+// GetTo returns ModuleStatsDateRangeInput.To, and is useful for accessing the field via an interface.
+func (v *ModuleStatsDateRangeInput) GetTo() string { return v.To }
+
+type ModuleStatsInput struct {
+	DateRange *ModuleStatsDateRangeInput `json:"dateRange,omitempty"`
+}
+
+// GetDateRange returns ModuleStatsInput.DateRange, and is useful for accessing the field via an interface.
+func (v *ModuleStatsInput) GetDateRange() *ModuleStatsDateRangeInput { return v.DateRange }
+
+// ModuleTagPage includes the GraphQL fields of MarketplaceModuleTagsConnection requested by the fragment ModuleTagPage.
+type ModuleTagPage struct {
+	Edges    []ModuleTagPageEdgesMarketplaceModuleTagsEdge `json:"edges"`
+	PageInfo ModuleTagPagePageInfo                         `json:"pageInfo"`
+}
+
+// GetEdges returns ModuleTagPage.Edges, and is useful for accessing the field via an interface.
+func (v *ModuleTagPage) GetEdges() []ModuleTagPageEdgesMarketplaceModuleTagsEdge { return v.Edges }
+
+// GetPageInfo returns ModuleTagPage.PageInfo, and is useful for accessing the field via an interface.
+func (v *ModuleTagPage) GetPageInfo() ModuleTagPagePageInfo { return v.PageInfo }
+
+// ModuleTagPageEdgesMarketplaceModuleTagsEdge includes the requested fields of the GraphQL type MarketplaceModuleTagsEdge.
+type ModuleTagPageEdgesMarketplaceModuleTagsEdge struct {
+	Node ModuleTagPageEdgesMarketplaceModuleTagsEdgeNodeMarketplaceModuleTagsItem `json:"node"`
+}
+
+// GetNode returns ModuleTagPageEdgesMarketplaceModuleTagsEdge.Node, and is useful for accessing the field via an interface.
+func (v *ModuleTagPageEdgesMarketplaceModuleTagsEdge) GetNode() ModuleTagPageEdgesMarketplaceModuleTagsEdgeNodeMarketplaceModuleTagsItem {
+	return v.Node
+}
+
+// ModuleTagPageEdgesMarketplaceModuleTagsEdgeNodeMarketplaceModuleTagsItem includes the requested fields of the GraphQL type MarketplaceModuleTagsItem.
+type ModuleTagPageEdgesMarketplaceModuleTagsEdgeNodeMarketplaceModuleTagsItem struct {
+	Value string `json:"value"`
+	Count int    `json:"count"`
+}
+
+// GetValue returns ModuleTagPageEdgesMarketplaceModuleTagsEdgeNodeMarketplaceModuleTagsItem.Value, and is useful for accessing the field via an interface.
+func (v *ModuleTagPageEdgesMarketplaceModuleTagsEdgeNodeMarketplaceModuleTagsItem) GetValue() string {
+	return v.Value
+}
+
+// GetCount returns ModuleTagPageEdgesMarketplaceModuleTagsEdgeNodeMarketplaceModuleTagsItem.Count, and is useful for accessing the field via an interface.
+func (v *ModuleTagPageEdgesMarketplaceModuleTagsEdgeNodeMarketplaceModuleTagsItem) GetCount() int {
+	return v.Count
+}
+
+// ModuleTagPagePageInfo includes the requested fields of the GraphQL type PageInfo.
+type ModuleTagPagePageInfo struct {
+	EndCursor   string `json:"endCursor"`
+	HasNextPage bool   `json:"hasNextPage"`
+}
+
+// GetEndCursor returns ModuleTagPagePageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *ModuleTagPagePageInfo) GetEndCursor() string { return v.EndCursor }
+
+// GetHasNextPage returns ModuleTagPagePageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *ModuleTagPagePageInfo) GetHasNextPage() bool { return v.HasNextPage }
+
+type ModuleTagsInput struct {
+	Category ModuleCategory `json:"category,omitempty"`
+	Value    string         `json:"value,omitempty"`
+}
+
+// GetCategory returns ModuleTagsInput.Category, and is useful for accessing the field via an interface.
+func (v *ModuleTagsInput) GetCategory() ModuleCategory { return v.Category }
+
+// GetValue returns ModuleTagsInput.Value, and is useful for accessing the field via an interface.
+func (v *ModuleTagsInput) GetValue() string { return v.Value }
+
+// ModuleVersionFields includes the GraphQL fields of VersionsV2Node requested by the fragment ModuleVersionFields.
+type ModuleVersionFields struct {
+	Version   string `json:"version"`
+	Created   int64  `json:"created"`
+	ChangeLog string `json:"changeLog"`
+}
+
+// GetVersion returns ModuleVersionFields.Version, and is useful for accessing the field via an interface.
+func (v *ModuleVersionFields) GetVersion() string { return v.Version }
+
+// GetCreated returns ModuleVersionFields.Created, and is useful for accessing the field via an interface.
+func (v *ModuleVersionFields) GetCreated() int64 { return v.Created }
+
+// GetChangeLog returns ModuleVersionFields.ChangeLog, and is useful for accessing the field via an interface.
+func (v *ModuleVersionFields) GetChangeLog() string { return v.ChangeLog }
+
+type ModuleVersionInput struct {
+	ChangeLog string `json:"changeLog"`
+	Version   string `json:"version"`
+}
+
+// GetChangeLog returns ModuleVersionInput.ChangeLog, and is useful for accessing the field via an interface.
+func (v *ModuleVersionInput) GetChangeLog() string { return v.ChangeLog }
+
+// GetVersion returns ModuleVersionInput.Version, and is useful for accessing the field via an interface.
+func (v *ModuleVersionInput) GetVersion() string { return v.Version }
+
+type OrgModuleTagsInput struct {
+	Category ModuleCategory `json:"category,omitempty"`
+	Value    string         `json:"value,omitempty"`
+}
+
+// GetCategory returns OrgModuleTagsInput.Category, and is useful for accessing the field via an interface.
+func (v *OrgModuleTagsInput) GetCategory() ModuleCategory { return v.Category }
+
+// GetValue returns OrgModuleTagsInput.Value, and is useful for accessing the field via an interface.
+func (v *OrgModuleTagsInput) GetValue() string { return v.Value }
+
+type PaymentInterval string
+
+const (
+	PaymentIntervalFree    PaymentInterval = "FREE"
+	PaymentIntervalMonthly PaymentInterval = "MONTHLY"
+	PaymentIntervalOnce    PaymentInterval = "ONCE"
+	PaymentIntervalYearly  PaymentInterval = "YEARLY"
+)
+
+type Period string
+
+const (
+	PeriodAnnually   Period = "ANNUALLY"
+	PeriodBiannually Period = "BIANNUALLY"
+	PeriodMonthly    Period = "MONTHLY"
+	PeriodQuarterly  Period = "QUARTERLY"
+)
+
+type PublicAppTileModuleSourceInfo struct {
+	Id string `json:"id"`
+}
+
+// GetId returns PublicAppTileModuleSourceInfo.Id, and is useful for accessing the field via an interface.
+func (v *PublicAppTileModuleSourceInfo) GetId() string { return v.Id }
+
+type PublishDraftModuleInputV2 struct {
+	IsTestModule bool               `json:"isTestModule"`
+	ModuleId     string             `json:"moduleId"`
+	ShowAuthor   bool               `json:"showAuthor"`
+	Version      ModuleVersionInput `json:"version"`
+}
+
+// GetIsTestModule returns PublishDraftModuleInputV2.IsTestModule, and is useful for accessing the field via an interface.
+func (v *PublishDraftModuleInputV2) GetIsTestModule() bool { return v.IsTestModule }
+
+// GetModuleId returns PublishDraftModuleInputV2.ModuleId, and is useful for accessing the field via an interface.
+func (v *PublishDraftModuleInputV2) GetModuleId() string { return v.ModuleId }
+
+// GetShowAuthor returns PublishDraftModuleInputV2.ShowAuthor, and is useful for accessing the field via an interface.
+func (v *PublishDraftModuleInputV2) GetShowAuthor() bool { return v.ShowAuthor }
+
+// GetVersion returns PublishDraftModuleInputV2.Version, and is useful for accessing the field via an interface.
+func (v *PublishDraftModuleInputV2) GetVersion() ModuleVersionInput { return v.Version }
+
+type PublishDraftModuleInputV3 struct {
+	IsTestModule bool               `json:"isTestModule"`
+	ModuleId     string             `json:"moduleId"`
+	ShowAuthor   bool               `json:"showAuthor"`
+	Version      ModuleVersionInput `json:"version"`
+}
+
+// GetIsTestModule returns PublishDraftModuleInputV3.IsTestModule, and is useful for accessing the field via an interface.
+func (v *PublishDraftModuleInputV3) GetIsTestModule() bool { return v.IsTestModule }
+
+// GetModuleId returns PublishDraftModuleInputV3.ModuleId, and is useful for accessing the field via an interface.
+func (v *PublishDraftModuleInputV3) GetModuleId() string { return v.ModuleId }
+
+// GetShowAuthor returns PublishDraftModuleInputV3.ShowAuthor, and is useful for accessing the field via an interface.
+func (v *PublishDraftModuleInputV3) GetShowAuthor() bool { return v.ShowAuthor }
+
+// GetVersion returns PublishDraftModuleInputV3.Version, and is useful for accessing the field via an interface.
+func (v *PublishDraftModuleInputV3) GetVersion() ModuleVersionInput { return v.Version }
+
+// PublishModulePublishDraftModuleV2PublishDraftModuleResponseV2 includes the requested fields of the GraphQL type PublishDraftModuleResponseV2.
+type PublishModulePublishDraftModuleV2PublishDraftModuleResponseV2 struct {
+	Id      string                                                                                    `json:"id"`
+	Version PublishModulePublishDraftModuleV2PublishDraftModuleResponseV2VersionModuleVersionResponse `json:"version"`
+}
+
+// GetId returns PublishModulePublishDraftModuleV2PublishDraftModuleResponseV2.Id, and is useful for accessing the field via an interface.
+func (v *PublishModulePublishDraftModuleV2PublishDraftModuleResponseV2) GetId() string { return v.Id }
+
+// GetVersion returns PublishModulePublishDraftModuleV2PublishDraftModuleResponseV2.Version, and is useful for accessing the field via an interface.
+func (v *PublishModulePublishDraftModuleV2PublishDraftModuleResponseV2) GetVersion() PublishModulePublishDraftModuleV2PublishDraftModuleResponseV2VersionModuleVersionResponse {
+	return v.Version
+}
+
+// PublishModulePublishDraftModuleV2PublishDraftModuleResponseV2VersionModuleVersionResponse includes the requested fields of the GraphQL type ModuleVersionResponse.
+type PublishModulePublishDraftModuleV2PublishDraftModuleResponseV2VersionModuleVersionResponse struct {
+	Version string `json:"version"`
+}
+
+// GetVersion returns PublishModulePublishDraftModuleV2PublishDraftModuleResponseV2VersionModuleVersionResponse.Version, and is useful for accessing the field via an interface.
+func (v *PublishModulePublishDraftModuleV2PublishDraftModuleResponseV2VersionModuleVersionResponse) GetVersion() string {
+	return v.Version
+}
+
+// PublishModuleResponse is returned by PublishModule on success.
+type PublishModuleResponse struct {
+	PublishDraftModuleV2 PublishModulePublishDraftModuleV2PublishDraftModuleResponseV2 `json:"publishDraftModuleV2"`
+}
+
+// GetPublishDraftModuleV2 returns PublishModuleResponse.PublishDraftModuleV2, and is useful for accessing the field via an interface.
+func (v *PublishModuleResponse) GetPublishDraftModuleV2() PublishModulePublishDraftModuleV2PublishDraftModuleResponseV2 {
+	return v.PublishDraftModuleV2
+}
+
+// PublishModuleV3PublishDraftModuleV3PublishDraftModuleResponseV3 includes the requested fields of the GraphQL type PublishDraftModuleResponseV3.
+type PublishModuleV3PublishDraftModuleV3PublishDraftModuleResponseV3 struct {
+	Id              string                                                                                       `json:"id"`
+	PublishReviewId string                                                                                       `json:"publishReviewId"`
+	Version         *PublishModuleV3PublishDraftModuleV3PublishDraftModuleResponseV3VersionModuleVersionResponse `json:"version"`
+}
+
+// GetId returns PublishModuleV3PublishDraftModuleV3PublishDraftModuleResponseV3.Id, and is useful for accessing the field via an interface.
+func (v *PublishModuleV3PublishDraftModuleV3PublishDraftModuleResponseV3) GetId() string { return v.Id }
+
+// GetPublishReviewId returns PublishModuleV3PublishDraftModuleV3PublishDraftModuleResponseV3.PublishReviewId, and is useful for accessing the field via an interface.
+func (v *PublishModuleV3PublishDraftModuleV3PublishDraftModuleResponseV3) GetPublishReviewId() string {
+	return v.PublishReviewId
+}
+
+// GetVersion returns PublishModuleV3PublishDraftModuleV3PublishDraftModuleResponseV3.Version, and is useful for accessing the field via an interface.
+func (v *PublishModuleV3PublishDraftModuleV3PublishDraftModuleResponseV3) GetVersion() *PublishModuleV3PublishDraftModuleV3PublishDraftModuleResponseV3VersionModuleVersionResponse {
+	return v.Version
+}
+
+// PublishModuleV3PublishDraftModuleV3PublishDraftModuleResponseV3VersionModuleVersionResponse includes the requested fields of the GraphQL type ModuleVersionResponse.
+type PublishModuleV3PublishDraftModuleV3PublishDraftModuleResponseV3VersionModuleVersionResponse struct {
+	Version string `json:"version"`
+}
+
+// GetVersion returns PublishModuleV3PublishDraftModuleV3PublishDraftModuleResponseV3VersionModuleVersionResponse.Version, and is useful for accessing the field via an interface.
+func (v *PublishModuleV3PublishDraftModuleV3PublishDraftModuleResponseV3VersionModuleVersionResponse) GetVersion() string {
+	return v.Version
+}
+
+// PublishModuleV3Response is returned by PublishModuleV3 on success.
+type PublishModuleV3Response struct {
+	// publish workflow which uses marketplace approval process
+	PublishDraftModuleV3 PublishModuleV3PublishDraftModuleV3PublishDraftModuleResponseV3 `json:"publishDraftModuleV3"`
+}
+
+// GetPublishDraftModuleV3 returns PublishModuleV3Response.PublishDraftModuleV3, and is useful for accessing the field via an interface.
+func (v *PublishModuleV3Response) GetPublishDraftModuleV3() PublishModuleV3PublishDraftModuleV3PublishDraftModuleResponseV3 {
+	return v.PublishDraftModuleV3
+}
+
+// PublishReviewFields includes the GraphQL fields of ModulePublishReview requested by the fragment PublishReviewFields.
+type PublishReviewFields struct {
+	Id                     string             `json:"id"`
+	ModuleId               string             `json:"moduleId"`
+	ModuleVersion          string             `json:"moduleVersion"`
+	ModuleVersionChangelog string             `json:"moduleVersionChangelog"`
+	Notes                  string             `json:"notes"`
+	Status                 ModuleReviewStatus `json:"status"`
+	CreatedBy              string             `json:"createdBy"`
+	Created                int64              `json:"created"`
+}
+
+// GetId returns PublishReviewFields.Id, and is useful for accessing the field via an interface.
+func (v *PublishReviewFields) GetId() string { return v.Id }
+
+// GetModuleId returns PublishReviewFields.ModuleId, and is useful for accessing the field via an interface.
+func (v *PublishReviewFields) GetModuleId() string { return v.ModuleId }
+
+// GetModuleVersion returns PublishReviewFields.ModuleVersion, and is useful for accessing the field via an interface.
+func (v *PublishReviewFields) GetModuleVersion() string { return v.ModuleVersion }
+
+// GetModuleVersionChangelog returns PublishReviewFields.ModuleVersionChangelog, and is useful for accessing the field via an interface.
+func (v *PublishReviewFields) GetModuleVersionChangelog() string { return v.ModuleVersionChangelog }
+
+// GetNotes returns PublishReviewFields.Notes, and is useful for accessing the field via an interface.
+func (v *PublishReviewFields) GetNotes() string { return v.Notes }
+
+// GetStatus returns PublishReviewFields.Status, and is useful for accessing the field via an interface.
+func (v *PublishReviewFields) GetStatus() ModuleReviewStatus { return v.Status }
+
+// GetCreatedBy returns PublishReviewFields.CreatedBy, and is useful for accessing the field via an interface.
+func (v *PublishReviewFields) GetCreatedBy() string { return v.CreatedBy }
+
+// GetCreated returns PublishReviewFields.Created, and is useful for accessing the field via an interface.
+func (v *PublishReviewFields) GetCreated() int64 { return v.Created }
+
+// PurchaseFields includes the GraphQL fields of Purchase requested by the fragment PurchaseFields.
+type PurchaseFields struct {
+	PurchaseId  string         `json:"purchaseId"`
+	ModuleId    string         `json:"moduleId"`
+	Status      PurchaseStatus `json:"status"`
+	Type        PurchaseType   `json:"type"`
+	PurchasedAt int64          `json:"purchasedAt"`
+	// Non-null if this purchase type is recurring and the subscription has been cancelled
+	CancelledAt *int64                             `json:"cancelledAt"`
+	Module      PurchaseFieldsModulePurchaseModule `json:"-"`
+}
+
+// GetPurchaseId returns PurchaseFields.PurchaseId, and is useful for accessing the field via an interface.
+func (v *PurchaseFields) GetPurchaseId() string { return v.PurchaseId }
+
+// GetModuleId returns PurchaseFields.ModuleId, and is useful for accessing the field via an interface.
+func (v *PurchaseFields) GetModuleId() string { return v.ModuleId }
+
+// GetStatus returns PurchaseFields.Status, and is useful for accessing the field via an interface.
+func (v *PurchaseFields) GetStatus() PurchaseStatus { return v.Status }
+
+// GetType returns PurchaseFields.Type, and is useful for accessing the field via an interface.
+func (v *PurchaseFields) GetType() PurchaseType { return v.Type }
+
+// GetPurchasedAt returns PurchaseFields.PurchasedAt, and is useful for accessing the field via an interface.
+func (v *PurchaseFields) GetPurchasedAt() int64 { return v.PurchasedAt }
+
+// GetCancelledAt returns PurchaseFields.CancelledAt, and is useful for accessing the field via an interface.
+func (v *PurchaseFields) GetCancelledAt() *int64 { return v.CancelledAt }
+
+// GetModule returns PurchaseFields.Module, and is useful for accessing the field via an interface.
+func (v *PurchaseFields) GetModule() PurchaseFieldsModulePurchaseModule { return v.Module }
+
+func (v *PurchaseFields) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*PurchaseFields
+		Module json.RawMessage `json:"module"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.PurchaseFields = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Module
+		src := firstPass.Module
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalPurchaseFieldsModulePurchaseModule(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"Unable to unmarshal PurchaseFields.Module: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalPurchaseFields struct {
+	PurchaseId string `json:"purchaseId"`
+
+	ModuleId string `json:"moduleId"`
+
+	Status PurchaseStatus `json:"status"`
+
+	Type PurchaseType `json:"type"`
+
+	PurchasedAt int64 `json:"purchasedAt"`
+
+	CancelledAt *int64 `json:"cancelledAt"`
+
+	Module json.RawMessage `json:"module"`
+}
+
+func (v *PurchaseFields) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *PurchaseFields) __premarshalJSON() (*__premarshalPurchaseFields, error) {
+	var retval __premarshalPurchaseFields
+
+	retval.PurchaseId = v.PurchaseId
+	retval.ModuleId = v.ModuleId
+	retval.Status = v.Status
+	retval.Type = v.Type
+	retval.PurchasedAt = v.PurchasedAt
+	retval.CancelledAt = v.CancelledAt
+	{
+
+		dst := &retval.Module
+		src := v.Module
+		var err error
+		*dst, err = __marshalPurchaseFieldsModulePurchaseModule(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"Unable to marshal PurchaseFields.Module: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+// PurchaseFieldsModuleMarketplaceModule includes the requested fields of the GraphQL type MarketplaceModule.
+type PurchaseFieldsModuleMarketplaceModule struct {
+	Typename string `json:"__typename"`
+	Id       string `json:"id"`
+	Title    string `json:"title"`
+	Version  string `json:"version"`
+}
+
+// GetTypename returns PurchaseFieldsModuleMarketplaceModule.Typename, and is useful for accessing the field via an interface.
+func (v *PurchaseFieldsModuleMarketplaceModule) GetTypename() string { return v.Typename }
+
+// GetId returns PurchaseFieldsModuleMarketplaceModule.Id, and is useful for accessing the field via an interface.
+func (v *PurchaseFieldsModuleMarketplaceModule) GetId() string { return v.Id }
+
+// GetTitle returns PurchaseFieldsModuleMarketplaceModule.Title, and is useful for accessing the field via an interface.
+func (v *PurchaseFieldsModuleMarketplaceModule) GetTitle() string { return v.Title }
+
+// GetVersion returns PurchaseFieldsModuleMarketplaceModule.Version, and is useful for accessing the field via an interface.
+func (v *PurchaseFieldsModuleMarketplaceModule) GetVersion() string { return v.Version }
+
+// PurchaseFieldsModuleModuleDeletedMessage includes the requested fields of the GraphQL type ModuleDeletedMessage.
+type PurchaseFieldsModuleModuleDeletedMessage struct {
+	Typename string `json:"__typename"`
+	ModuleId string `json:"moduleId"`
+	Message  string `json:"message"`
+}
+
+// GetTypename returns PurchaseFieldsModuleModuleDeletedMessage.Typename, and is useful for accessing the field via an interface.
+func (v *PurchaseFieldsModuleModuleDeletedMessage) GetTypename() string { return v.Typename }
+
+// GetModuleId returns PurchaseFieldsModuleModuleDeletedMessage.ModuleId, and is useful for accessing the field via an interface.
+func (v *PurchaseFieldsModuleModuleDeletedMessage) GetModuleId() string { return v.ModuleId }
+
+// GetMessage returns PurchaseFieldsModuleModuleDeletedMessage.Message, and is useful for accessing the field via an interface.
+func (v *PurchaseFieldsModuleModuleDeletedMessage) GetMessage() string { return v.Message }
+
+// PurchaseFieldsModulePurchaseModule includes the requested fields of the GraphQL interface PurchaseModule.
+//
+// PurchaseFieldsModulePurchaseModule is implemented by the following types:
+// PurchaseFieldsModuleMarketplaceModule
+// PurchaseFieldsModuleModuleDeletedMessage
+type PurchaseFieldsModulePurchaseModule interface {
+	implementsGraphQLInterfacePurchaseFieldsModulePurchaseModule()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() string
+}
+
+func (v *PurchaseFieldsModuleMarketplaceModule) implementsGraphQLInterfacePurchaseFieldsModulePurchaseModule() {
+}
+func (v *PurchaseFieldsModuleModuleDeletedMessage) implementsGraphQLInterfacePurchaseFieldsModulePurchaseModule() {
+}
+
+func __unmarshalPurchaseFieldsModulePurchaseModule(b []byte, v *PurchaseFieldsModulePurchaseModule) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "MarketplaceModule":
+		*v = new(PurchaseFieldsModuleMarketplaceModule)
+		return json.Unmarshal(b, *v)
+	case "ModuleDeletedMessage":
+		*v = new(PurchaseFieldsModuleModuleDeletedMessage)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing PurchaseModule.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for PurchaseFieldsModulePurchaseModule: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalPurchaseFieldsModulePurchaseModule(v *PurchaseFieldsModulePurchaseModule) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *PurchaseFieldsModuleMarketplaceModule:
+		typename = "MarketplaceModule"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*PurchaseFieldsModuleMarketplaceModule
+		}{typename, v}
+		return json.Marshal(result)
+	case *PurchaseFieldsModuleModuleDeletedMessage:
+		typename = "ModuleDeletedMessage"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*PurchaseFieldsModuleModuleDeletedMessage
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for PurchaseFieldsModulePurchaseModule: "%T"`, v)
+	}
+}
+
+type PurchaseStatus string
+
+const (
+	PurchaseStatusActive   PurchaseStatus = "ACTIVE"
+	PurchaseStatusInactive PurchaseStatus = "INACTIVE"
+)
+
+type PurchaseType string
+
+const (
+	PurchaseTypeOneTime   PurchaseType = "ONE_TIME"
+	PurchaseTypeRecurring PurchaseType = "RECURRING"
+)
+
+// RemoveDraftModuleIconRemoveDraftModuleIconV2RemoveDraftModuleIconV2Response includes the requested fields of the GraphQL type RemoveDraftModuleIconV2Response.
+type RemoveDraftModuleIconRemoveDraftModuleIconV2RemoveDraftModuleIconV2Response struct {
+	ModuleId string `json:"moduleId"`
+}
+
+// GetModuleId returns RemoveDraftModuleIconRemoveDraftModuleIconV2RemoveDraftModuleIconV2Response.ModuleId, and is useful for accessing the field via an interface.
+func (v *RemoveDraftModuleIconRemoveDraftModuleIconV2RemoveDraftModuleIconV2Response) GetModuleId() string {
+	return v.ModuleId
+}
+
+// RemoveDraftModuleIconResponse is returned by RemoveDraftModuleIcon on success.
+type RemoveDraftModuleIconResponse struct {
+	// Removes the `iconV2` image from the draft
+	RemoveDraftModuleIconV2 RemoveDraftModuleIconRemoveDraftModuleIconV2RemoveDraftModuleIconV2Response `json:"removeDraftModuleIconV2"`
+}
+
+// GetRemoveDraftModuleIconV2 returns RemoveDraftModuleIconResponse.RemoveDraftModuleIconV2, and is useful for accessing the field via an interface.
+func (v *RemoveDraftModuleIconResponse) GetRemoveDraftModuleIconV2() RemoveDraftModuleIconRemoveDraftModuleIconV2RemoveDraftModuleIconV2Response {
+	return v.RemoveDraftModuleIconV2
+}
+
+type RemoveDraftModuleIconV2Input struct {
+	ModuleId string `json:"moduleId"`
+}
+
+// GetModuleId returns RemoveDraftModuleIconV2Input.ModuleId, and is useful for accessing the field via an interface.
+func (v *RemoveDraftModuleIconV2Input) GetModuleId() string { return v.ModuleId }
+
+// RemoveDraftModulePreviewImageRemoveDraftModulePreviewImagesV2RemoveDraftModulePreviewImagesV2Response includes the requested fields of the GraphQL type RemoveDraftModulePreviewImagesV2Response.
+type RemoveDraftModulePreviewImageRemoveDraftModulePreviewImagesV2RemoveDraftModulePreviewImagesV2Response struct {
+	ModuleId string `json:"moduleId"`
+}
+
+// GetModuleId returns RemoveDraftModulePreviewImageRemoveDraftModulePreviewImagesV2RemoveDraftModulePreviewImagesV2Response.ModuleId, and is useful for accessing the field via an interface.
+func (v *RemoveDraftModulePreviewImageRemoveDraftModulePreviewImagesV2RemoveDraftModulePreviewImagesV2Response) GetModuleId() string {
+	return v.ModuleId
+}
+
+// RemoveDraftModulePreviewImageResponse is returned by RemoveDraftModulePreviewImage on success.
+type RemoveDraftModulePreviewImageResponse struct {
+	// Removes the preview image (`previewImageV2`) from the draft identified by the `imageId`, `fileName` and `fileExtension`
+	RemoveDraftModulePreviewImagesV2 RemoveDraftModulePreviewImageRemoveDraftModulePreviewImagesV2RemoveDraftModulePreviewImagesV2Response `json:"removeDraftModulePreviewImagesV2"`
+}
+
+// GetRemoveDraftModulePreviewImagesV2 returns RemoveDraftModulePreviewImageResponse.RemoveDraftModulePreviewImagesV2, and is useful for accessing the field via an interface.
+func (v *RemoveDraftModulePreviewImageResponse) GetRemoveDraftModulePreviewImagesV2() RemoveDraftModulePreviewImageRemoveDraftModulePreviewImagesV2RemoveDraftModulePreviewImagesV2Response {
+	return v.RemoveDraftModulePreviewImagesV2
+}
+
+type RemoveDraftModulePreviewImagesV2Input struct {
+	FileExtension string `json:"fileExtension"`
+	FileName      string `json:"fileName"`
+	ModuleId      string `json:"moduleId"`
+}
+
+// GetFileExtension returns RemoveDraftModulePreviewImagesV2Input.FileExtension, and is useful for accessing the field via an interface.
+func (v *RemoveDraftModulePreviewImagesV2Input) GetFileExtension() string { return v.FileExtension }
+
+// GetFileName returns RemoveDraftModulePreviewImagesV2Input.FileName, and is useful for accessing the field via an interface.
+func (v *RemoveDraftModulePreviewImagesV2Input) GetFileName() string { return v.FileName }
+
+// GetModuleId returns RemoveDraftModulePreviewImagesV2Input.ModuleId, and is useful for accessing the field via an interface.
+func (v *RemoveDraftModulePreviewImagesV2Input) GetModuleId() string { return v.ModuleId }
+
+type RemoveMarketplaceReviewReplyInput_v2 struct {
+	ModuleId string `json:"moduleId"`
+	RatingId string `json:"ratingId"`
+	ReplyId  string `json:"replyId"`
+}
+
+// GetModuleId returns RemoveMarketplaceReviewReplyInput_v2.ModuleId, and is useful for accessing the field via an interface.
+func (v *RemoveMarketplaceReviewReplyInput_v2) GetModuleId() string { return v.ModuleId }
+
+// GetRatingId returns RemoveMarketplaceReviewReplyInput_v2.RatingId, and is useful for accessing the field via an interface.
+func (v *RemoveMarketplaceReviewReplyInput_v2) GetRatingId() string { return v.RatingId }
+
+// GetReplyId returns RemoveMarketplaceReviewReplyInput_v2.ReplyId, and is useful for accessing the field via an interface.
+func (v *RemoveMarketplaceReviewReplyInput_v2) GetReplyId() string { return v.ReplyId }
+
+// RemoveMarketplaceReviewReplyRemoveMarketplaceReviewReply_v2Review includes the requested fields of the GraphQL type Review.
+type RemoveMarketplaceReviewReplyRemoveMarketplaceReviewReply_v2Review struct {
+	Id string `json:"id"`
+}
+
+// GetId returns RemoveMarketplaceReviewReplyRemoveMarketplaceReviewReply_v2Review.Id, and is useful for accessing the field via an interface.
+func (v *RemoveMarketplaceReviewReplyRemoveMarketplaceReviewReply_v2Review) GetId() string {
+	return v.Id
+}
+
+// RemoveMarketplaceReviewReplyResponse is returned by RemoveMarketplaceReviewReply on success.
+type RemoveMarketplaceReviewReplyResponse struct {
+	RemoveMarketplaceReviewReply_v2 RemoveMarketplaceReviewReplyRemoveMarketplaceReviewReply_v2Review `json:"removeMarketplaceReviewReply_v2"`
+}
+
+// GetRemoveMarketplaceReviewReply_v2 returns RemoveMarketplaceReviewReplyResponse.RemoveMarketplaceReviewReply_v2, and is useful for accessing the field via an interface.
+func (v *RemoveMarketplaceReviewReplyResponse) GetRemoveMarketplaceReviewReply_v2() RemoveMarketplaceReviewReplyRemoveMarketplaceReviewReply_v2Review {
+	return v.RemoveMarketplaceReviewReply_v2
+}
+
+// ReviewFields includes the GraphQL fields of Review requested by the fragment ReviewFields.
+type ReviewFields struct {
+	Id            string                  `json:"id"`
+	Rating        int                     `json:"rating"`
+	DisplayName   string                  `json:"displayName"`
+	EntityVersion string                  `json:"entityVersion"`
+	CreatedAt     string                  `json:"createdAt"`
+	UserId        string                  `json:"userId"`
+	Comment       ReviewFieldsCommentPost `json:"-"`
+}
+
+// GetId returns ReviewFields.Id, and is useful for accessing the field via an interface.
+func (v *ReviewFields) GetId() string { return v.Id }
+
+// GetRating returns ReviewFields.Rating, and is useful for accessing the field via an interface.
+func (v *ReviewFields) GetRating() int { return v.Rating }
+
+// GetDisplayName returns ReviewFields.DisplayName, and is useful for accessing the field via an interface.
+func (v *ReviewFields) GetDisplayName() string { return v.DisplayName }
+
+// GetEntityVersion returns ReviewFields.EntityVersion, and is useful for accessing the field via an interface.
+func (v *ReviewFields) GetEntityVersion() string { return v.EntityVersion }
+
+// GetCreatedAt returns ReviewFields.CreatedAt, and is useful for accessing the field via an interface.
+func (v *ReviewFields) GetCreatedAt() string { return v.CreatedAt }
+
+// GetUserId returns ReviewFields.UserId, and is useful for accessing the field via an interface.
+func (v *ReviewFields) GetUserId() string { return v.UserId }
+
+// GetComment returns ReviewFields.Comment, and is useful for accessing the field via an interface.
+func (v *ReviewFields) GetComment() ReviewFieldsCommentPost { return v.Comment }
+
+func (v *ReviewFields) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*ReviewFields
+		Comment json.RawMessage `json:"comment"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.ReviewFields = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Comment
+		src := firstPass.Comment
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalReviewFieldsCommentPost(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"Unable to unmarshal ReviewFields.Comment: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalReviewFields struct {
+	Id string `json:"id"`
+
+	Rating int `json:"rating"`
+
+	DisplayName string `json:"displayName"`
+
+	EntityVersion string `json:"entityVersion"`
+
+	CreatedAt string `json:"createdAt"`
+
+	UserId string `json:"userId"`
+
+	Comment json.RawMessage `json:"comment"`
+}
+
+func (v *ReviewFields) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *ReviewFields) __premarshalJSON() (*__premarshalReviewFields, error) {
+	var retval __premarshalReviewFields
+
+	retval.Id = v.Id
+	retval.Rating = v.Rating
+	retval.DisplayName = v.DisplayName
+	retval.EntityVersion = v.EntityVersion
+	retval.CreatedAt = v.CreatedAt
+	retval.UserId = v.UserId
+	{
+
+		dst := &retval.Comment
+		src := v.Comment
+		var err error
+		*dst, err = __marshalReviewFieldsCommentPost(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"Unable to marshal ReviewFields.Comment: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+// ReviewFieldsCommentActivePost includes the requested fields of the GraphQL type ActivePost.
+type ReviewFieldsCommentActivePost struct {
+	Typename string                                       `json:"__typename"`
+	Id       string                                       `json:"id"`
+	Message  string                                       `json:"message"`
+	Replies  ReviewFieldsCommentPostRepliesPostConnection `json:"replies"`
+}
+
+// GetTypename returns ReviewFieldsCommentActivePost.Typename, and is useful for accessing the field via an interface.
+func (v *ReviewFieldsCommentActivePost) GetTypename() string { return v.Typename }
+
+// GetId returns ReviewFieldsCommentActivePost.Id, and is useful for accessing the field via an interface.
+func (v *ReviewFieldsCommentActivePost) GetId() string { return v.Id }
+
+// GetMessage returns ReviewFieldsCommentActivePost.Message, and is useful for accessing the field via an interface.
+func (v *ReviewFieldsCommentActivePost) GetMessage() string { return v.Message }
+
+// GetReplies returns ReviewFieldsCommentActivePost.Replies, and is useful for accessing the field via an interface.
+func (v *ReviewFieldsCommentActivePost) GetReplies() ReviewFieldsCommentPostRepliesPostConnection {
+	return v.Replies
+}
+
+// ReviewFieldsCommentDeletedPost includes the requested fields of the GraphQL type DeletedPost.
+type ReviewFieldsCommentDeletedPost struct {
+	Typename string                                       `json:"__typename"`
+	Id       string                                       `json:"id"`
+	Replies  ReviewFieldsCommentPostRepliesPostConnection `json:"replies"`
+}
+
+// GetTypename returns ReviewFieldsCommentDeletedPost.Typename, and is useful for accessing the field via an interface.
+func (v *ReviewFieldsCommentDeletedPost) GetTypename() string { return v.Typename }
+
+// GetId returns ReviewFieldsCommentDeletedPost.Id, and is useful for accessing the field via an interface.
+func (v *ReviewFieldsCommentDeletedPost) GetId() string { return v.Id }
+
+// GetReplies returns ReviewFieldsCommentDeletedPost.Replies, and is useful for accessing the field via an interface.
+func (v *ReviewFieldsCommentDeletedPost) GetReplies() ReviewFieldsCommentPostRepliesPostConnection {
+	return v.Replies
+}
+
+// ReviewFieldsCommentPost includes the requested fields of the GraphQL interface Post.
+//
+// ReviewFieldsCommentPost is implemented by the following types:
+// ReviewFieldsCommentActivePost
+// ReviewFieldsCommentDeletedPost
+type ReviewFieldsCommentPost interface {
+	implementsGraphQLInterfaceReviewFieldsCommentPost()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() string
+	// GetId returns the interface-field "id" from its implementation.
+	GetId() string
+	// GetReplies returns the interface-field "replies" from its implementation.
+	GetReplies() ReviewFieldsCommentPostRepliesPostConnection
+}
+
+func (v *ReviewFieldsCommentActivePost) implementsGraphQLInterfaceReviewFieldsCommentPost()  {}
+func (v *ReviewFieldsCommentDeletedPost) implementsGraphQLInterfaceReviewFieldsCommentPost() {}
+
+func __unmarshalReviewFieldsCommentPost(b []byte, v *ReviewFieldsCommentPost) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "ActivePost":
+		*v = new(ReviewFieldsCommentActivePost)
+		return json.Unmarshal(b, *v)
+	case "DeletedPost":
+		*v = new(ReviewFieldsCommentDeletedPost)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing Post.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for ReviewFieldsCommentPost: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalReviewFieldsCommentPost(v *ReviewFieldsCommentPost) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *ReviewFieldsCommentActivePost:
+		typename = "ActivePost"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*ReviewFieldsCommentActivePost
+		}{typename, v}
+		return json.Marshal(result)
+	case *ReviewFieldsCommentDeletedPost:
+		typename = "DeletedPost"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*ReviewFieldsCommentDeletedPost
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for ReviewFieldsCommentPost: "%T"`, v)
+	}
+}
+
+// ReviewFieldsCommentPostRepliesPostConnection includes the requested fields of the GraphQL type PostConnection.
+type ReviewFieldsCommentPostRepliesPostConnection struct {
+	Edges    []ReviewFieldsCommentPostRepliesPostConnectionEdgesPostEdge `json:"edges"`
+	PageInfo ReviewFieldsCommentPostRepliesPostConnectionPageInfo        `json:"pageInfo"`
+}
+
+// GetEdges returns ReviewFieldsCommentPostRepliesPostConnection.Edges, and is useful for accessing the field via an interface.
+func (v *ReviewFieldsCommentPostRepliesPostConnection) GetEdges() []ReviewFieldsCommentPostRepliesPostConnectionEdgesPostEdge {
+	return v.Edges
+}
+
+// GetPageInfo returns ReviewFieldsCommentPostRepliesPostConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *ReviewFieldsCommentPostRepliesPostConnection) GetPageInfo() ReviewFieldsCommentPostRepliesPostConnectionPageInfo {
+	return v.PageInfo
+}
+
+// ReviewFieldsCommentPostRepliesPostConnectionEdgesPostEdge includes the requested fields of the GraphQL type PostEdge.
+type ReviewFieldsCommentPostRepliesPostConnectionEdgesPostEdge struct {
+	Node ReviewFieldsCommentPostRepliesPostConnectionEdgesPostEdgeNodePost `json:"-"`
+}
+
+// GetNode returns ReviewFieldsCommentPostRepliesPostConnectionEdgesPostEdge.Node, and is useful for accessing the field via an interface.
+func (v *ReviewFieldsCommentPostRepliesPostConnectionEdgesPostEdge) GetNode() ReviewFieldsCommentPostRepliesPostConnectionEdgesPostEdgeNodePost {
+	return v.Node
+}
+
+func (v *ReviewFieldsCommentPostRepliesPostConnectionEdgesPostEdge) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*ReviewFieldsCommentPostRepliesPostConnectionEdgesPostEdge
+		Node json.RawMessage `json:"node"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.ReviewFieldsCommentPostRepliesPostConnectionEdgesPostEdge = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Node
+		src := firstPass.Node
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalReviewFieldsCommentPostRepliesPostConnectionEdgesPostEdgeNodePost(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"Unable to unmarshal ReviewFieldsCommentPostRepliesPostConnectionEdgesPostEdge.Node: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalReviewFieldsCommentPostRepliesPostConnectionEdgesPostEdge struct {
+	Node json.RawMessage `json:"node"`
+}
+
+func (v *ReviewFieldsCommentPostRepliesPostConnectionEdgesPostEdge) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *ReviewFieldsCommentPostRepliesPostConnectionEdgesPostEdge) __premarshalJSON() (*__premarshalReviewFieldsCommentPostRepliesPostConnectionEdgesPostEdge, error) {
+	var retval __premarshalReviewFieldsCommentPostRepliesPostConnectionEdgesPostEdge
+
+	{
+
+		dst := &retval.Node
+		src := v.Node
+		var err error
+		*dst, err = __marshalReviewFieldsCommentPostRepliesPostConnectionEdgesPostEdgeNodePost(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"Unable to marshal ReviewFieldsCommentPostRepliesPostConnectionEdgesPostEdge.Node: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+// ReviewFieldsCommentPostRepliesPostConnectionEdgesPostEdgeNodeActivePost includes the requested fields of the GraphQL type ActivePost.
+type ReviewFieldsCommentPostRepliesPostConnectionEdgesPostEdgeNodeActivePost struct {
+	Typename                    string `json:"__typename"`
+	ReviewReplyFieldsActivePost `json:"-"`
+}
+
+// GetTypename returns ReviewFieldsCommentPostRepliesPostConnectionEdgesPostEdgeNodeActivePost.Typename, and is useful for accessing the field via an interface.
+func (v *ReviewFieldsCommentPostRepliesPostConnectionEdgesPostEdgeNodeActivePost) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns ReviewFieldsCommentPostRepliesPostConnectionEdgesPostEdgeNodeActivePost.Id, and is useful for accessing the field via an interface.
+func (v *ReviewFieldsCommentPostRepliesPostConnectionEdgesPostEdgeNodeActivePost) GetId() string {
+	return v.ReviewReplyFieldsActivePost.Id
+}
+
+// GetMessage returns ReviewFieldsCommentPostRepliesPostConnectionEdgesPostEdgeNodeActivePost.Message, and is useful for accessing the field via an interface.
+func (v *ReviewFieldsCommentPostRepliesPostConnectionEdgesPostEdgeNodeActivePost) GetMessage() string {
+	return v.ReviewReplyFieldsActivePost.Message
+}
+
+// GetAuthorId returns ReviewFieldsCommentPostRepliesPostConnectionEdgesPostEdgeNodeActivePost.AuthorId, and is useful for accessing the field via an interface.
+func (v *ReviewFieldsCommentPostRepliesPostConnectionEdgesPostEdgeNodeActivePost) GetAuthorId() string {
+	return v.ReviewReplyFieldsActivePost.AuthorId
+}
+
+func (v *ReviewFieldsCommentPostRepliesPostConnectionEdgesPostEdgeNodeActivePost) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*ReviewFieldsCommentPostRepliesPostConnectionEdgesPostEdgeNodeActivePost
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.ReviewFieldsCommentPostRepliesPostConnectionEdgesPostEdgeNodeActivePost = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.ReviewReplyFieldsActivePost)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalReviewFieldsCommentPostRepliesPostConnectionEdgesPostEdgeNodeActivePost struct {
+	Typename string `json:"__typename"`
+
+	Id string `json:"id"`
+
+	Message string `json:"message"`
+
+	AuthorId string `json:"authorId"`
+}
+
+func (v *ReviewFieldsCommentPostRepliesPostConnectionEdgesPostEdgeNodeActivePost) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *ReviewFieldsCommentPostRepliesPostConnectionEdgesPostEdgeNodeActivePost) __premarshalJSON() (*__premarshalReviewFieldsCommentPostRepliesPostConnectionEdgesPostEdgeNodeActivePost, error) {
+	var retval __premarshalReviewFieldsCommentPostRepliesPostConnectionEdgesPostEdgeNodeActivePost
+
+	retval.Typename = v.Typename
+	retval.Id = v.ReviewReplyFieldsActivePost.Id
+	retval.Message = v.ReviewReplyFieldsActivePost.Message
+	retval.AuthorId = v.ReviewReplyFieldsActivePost.AuthorId
+	return &retval, nil
+}
+
+// ReviewFieldsCommentPostRepliesPostConnectionEdgesPostEdgeNodeDeletedPost includes the requested fields of the GraphQL type DeletedPost.
+type ReviewFieldsCommentPostRepliesPostConnectionEdgesPostEdgeNodeDeletedPost struct {
+	Typename                     string `json:"__typename"`
+	ReviewReplyFieldsDeletedPost `json:"-"`
+}
+
+// GetTypename returns ReviewFieldsCommentPostRepliesPostConnectionEdgesPostEdgeNodeDeletedPost.Typename, and is useful for accessing the field via an interface.
+func (v *ReviewFieldsCommentPostRepliesPostConnectionEdgesPostEdgeNodeDeletedPost) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns ReviewFieldsCommentPostRepliesPostConnectionEdgesPostEdgeNodeDeletedPost.Id, and is useful for accessing the field via an interface.
+func (v *ReviewFieldsCommentPostRepliesPostConnectionEdgesPostEdgeNodeDeletedPost) GetId() string {
+	return v.ReviewReplyFieldsDeletedPost.Id
+}
+
+func (v *ReviewFieldsCommentPostRepliesPostConnectionEdgesPostEdgeNodeDeletedPost) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*ReviewFieldsCommentPostRepliesPostConnectionEdgesPostEdgeNodeDeletedPost
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.ReviewFieldsCommentPostRepliesPostConnectionEdgesPostEdgeNodeDeletedPost = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.ReviewReplyFieldsDeletedPost)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalReviewFieldsCommentPostRepliesPostConnectionEdgesPostEdgeNodeDeletedPost struct {
+	Typename string `json:"__typename"`
+
+	Id string `json:"id"`
+}
+
+func (v *ReviewFieldsCommentPostRepliesPostConnectionEdgesPostEdgeNodeDeletedPost) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *ReviewFieldsCommentPostRepliesPostConnectionEdgesPostEdgeNodeDeletedPost) __premarshalJSON() (*__premarshalReviewFieldsCommentPostRepliesPostConnectionEdgesPostEdgeNodeDeletedPost, error) {
+	var retval __premarshalReviewFieldsCommentPostRepliesPostConnectionEdgesPostEdgeNodeDeletedPost
+
+	retval.Typename = v.Typename
+	retval.Id = v.ReviewReplyFieldsDeletedPost.Id
+	return &retval, nil
+}
+
+// ReviewFieldsCommentPostRepliesPostConnectionEdgesPostEdgeNodePost includes the requested fields of the GraphQL interface Post.
+//
+// ReviewFieldsCommentPostRepliesPostConnectionEdgesPostEdgeNodePost is implemented by the following types:
+// ReviewFieldsCommentPostRepliesPostConnectionEdgesPostEdgeNodeActivePost
+// ReviewFieldsCommentPostRepliesPostConnectionEdgesPostEdgeNodeDeletedPost
+type ReviewFieldsCommentPostRepliesPostConnectionEdgesPostEdgeNodePost interface {
+	implementsGraphQLInterfaceReviewFieldsCommentPostRepliesPostConnectionEdgesPostEdgeNodePost()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() string
+	ReviewReplyFields
+}
+
+func (v *ReviewFieldsCommentPostRepliesPostConnectionEdgesPostEdgeNodeActivePost) implementsGraphQLInterfaceReviewFieldsCommentPostRepliesPostConnectionEdgesPostEdgeNodePost() {
+}
+func (v *ReviewFieldsCommentPostRepliesPostConnectionEdgesPostEdgeNodeDeletedPost) implementsGraphQLInterfaceReviewFieldsCommentPostRepliesPostConnectionEdgesPostEdgeNodePost() {
+}
+
+func __unmarshalReviewFieldsCommentPostRepliesPostConnectionEdgesPostEdgeNodePost(b []byte, v *ReviewFieldsCommentPostRepliesPostConnectionEdgesPostEdgeNodePost) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "ActivePost":
+		*v = new(ReviewFieldsCommentPostRepliesPostConnectionEdgesPostEdgeNodeActivePost)
+		return json.Unmarshal(b, *v)
+	case "DeletedPost":
+		*v = new(ReviewFieldsCommentPostRepliesPostConnectionEdgesPostEdgeNodeDeletedPost)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing Post.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for ReviewFieldsCommentPostRepliesPostConnectionEdgesPostEdgeNodePost: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalReviewFieldsCommentPostRepliesPostConnectionEdgesPostEdgeNodePost(v *ReviewFieldsCommentPostRepliesPostConnectionEdgesPostEdgeNodePost) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *ReviewFieldsCommentPostRepliesPostConnectionEdgesPostEdgeNodeActivePost:
+		typename = "ActivePost"
+
+		premarshaled, err := v.__premarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*__premarshalReviewFieldsCommentPostRepliesPostConnectionEdgesPostEdgeNodeActivePost
+		}{typename, premarshaled}
+		return json.Marshal(result)
+	case *ReviewFieldsCommentPostRepliesPostConnectionEdgesPostEdgeNodeDeletedPost:
+		typename = "DeletedPost"
+
+		premarshaled, err := v.__premarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*__premarshalReviewFieldsCommentPostRepliesPostConnectionEdgesPostEdgeNodeDeletedPost
+		}{typename, premarshaled}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for ReviewFieldsCommentPostRepliesPostConnectionEdgesPostEdgeNodePost: "%T"`, v)
+	}
+}
+
+// ReviewFieldsCommentPostRepliesPostConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+type ReviewFieldsCommentPostRepliesPostConnectionPageInfo struct {
+	EndCursor   string `json:"endCursor"`
+	HasNextPage bool   `json:"hasNextPage"`
+}
+
+// GetEndCursor returns ReviewFieldsCommentPostRepliesPostConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *ReviewFieldsCommentPostRepliesPostConnectionPageInfo) GetEndCursor() string {
+	return v.EndCursor
+}
+
+// GetHasNextPage returns ReviewFieldsCommentPostRepliesPostConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *ReviewFieldsCommentPostRepliesPostConnectionPageInfo) GetHasNextPage() bool {
+	return v.HasNextPage
+}
+
+// ReviewQueueModule includes the GraphQL fields of DraftMarketplaceModule requested by the fragment ReviewQueueModule.
+type ReviewQueueModule struct {
+	Id               string                                         `json:"id"`
+	Title            string                                         `json:"title"`
+	Description      string                                         `json:"description"`
+	Category         ModuleCategory                                 `json:"category"`
+	ParentModuleId   string                                         `json:"parentModuleId"`
+	AssignedReviewer string                                         `json:"assignedReviewer"`
+	Organization     ReviewQueueModuleOrganizationOrganizationField `json:"organization"`
+}
+
+// GetId returns ReviewQueueModule.Id, and is useful for accessing the field via an interface.
+func (v *ReviewQueueModule) GetId() string { return v.Id }
+
+// GetTitle returns ReviewQueueModule.Title, and is useful for accessing the field via an interface.
+func (v *ReviewQueueModule) GetTitle() string { return v.Title }
+
+// GetDescription returns ReviewQueueModule.Description, and is useful for accessing the field via an interface.
+func (v *ReviewQueueModule) GetDescription() string { return v.Description }
+
+// GetCategory returns ReviewQueueModule.Category, and is useful for accessing the field via an interface.
+func (v *ReviewQueueModule) GetCategory() ModuleCategory { return v.Category }
+
+// GetParentModuleId returns ReviewQueueModule.ParentModuleId, and is useful for accessing the field via an interface.
+func (v *ReviewQueueModule) GetParentModuleId() string { return v.ParentModuleId }
+
+// GetAssignedReviewer returns ReviewQueueModule.AssignedReviewer, and is useful for accessing the field via an interface.
+func (v *ReviewQueueModule) GetAssignedReviewer() string { return v.AssignedReviewer }
+
+// GetOrganization returns ReviewQueueModule.Organization, and is useful for accessing the field via an interface.
+func (v *ReviewQueueModule) GetOrganization() ReviewQueueModuleOrganizationOrganizationField {
+	return v.Organization
+}
+
+// ReviewQueueModuleOrganizationOrganizationField includes the requested fields of the GraphQL type OrganizationField.
+type ReviewQueueModuleOrganizationOrganizationField struct {
+	Id   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// GetId returns ReviewQueueModuleOrganizationOrganizationField.Id, and is useful for accessing the field via an interface.
+func (v *ReviewQueueModuleOrganizationOrganizationField) GetId() string { return v.Id }
+
+// GetName returns ReviewQueueModuleOrganizationOrganizationField.Name, and is useful for accessing the field via an interface.
+func (v *ReviewQueueModuleOrganizationOrganizationField) GetName() string { return v.Name }
+
+// ReviewReplyFields includes the GraphQL fields of Post requested by the fragment ReviewReplyFields.
+//
+// ReviewReplyFields is implemented by the following types:
+// ReviewReplyFieldsActivePost
+// ReviewReplyFieldsDeletedPost
+type ReviewReplyFields interface {
+	implementsGraphQLInterfaceReviewReplyFields()
+	// GetId returns the interface-field "id" from its implementation.
+	GetId() string
+}
+
+func (v *ReviewReplyFieldsActivePost) implementsGraphQLInterfaceReviewReplyFields()  {}
+func (v *ReviewReplyFieldsDeletedPost) implementsGraphQLInterfaceReviewReplyFields() {}
+
+func __unmarshalReviewReplyFields(b []byte, v *ReviewReplyFields) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "ActivePost":
+		*v = new(ReviewReplyFieldsActivePost)
+		return json.Unmarshal(b, *v)
+	case "DeletedPost":
+		*v = new(ReviewReplyFieldsDeletedPost)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing Post.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for ReviewReplyFields: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalReviewReplyFields(v *ReviewReplyFields) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *ReviewReplyFieldsActivePost:
+		typename = "ActivePost"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*ReviewReplyFieldsActivePost
+		}{typename, v}
+		return json.Marshal(result)
+	case *ReviewReplyFieldsDeletedPost:
+		typename = "DeletedPost"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*ReviewReplyFieldsDeletedPost
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for ReviewReplyFields: "%T"`, v)
+	}
+}
+
+// ReviewReplyFields includes the GraphQL fields of ActivePost requested by the fragment ReviewReplyFields.
+type ReviewReplyFieldsActivePost struct {
+	Id       string `json:"id"`
+	Message  string `json:"message"`
+	AuthorId string `json:"authorId"`
+}
+
+// GetId returns ReviewReplyFieldsActivePost.Id, and is useful for accessing the field via an interface.
+func (v *ReviewReplyFieldsActivePost) GetId() string { return v.Id }
+
+// GetMessage returns ReviewReplyFieldsActivePost.Message, and is useful for accessing the field via an interface.
+func (v *ReviewReplyFieldsActivePost) GetMessage() string { return v.Message }
+
+// GetAuthorId returns ReviewReplyFieldsActivePost.AuthorId, and is useful for accessing the field via an interface.
+func (v *ReviewReplyFieldsActivePost) GetAuthorId() string { return v.AuthorId }
+
+// ReviewReplyFields includes the GraphQL fields of DeletedPost requested by the fragment ReviewReplyFields.
+type ReviewReplyFieldsDeletedPost struct {
+	Id string `json:"id"`
+}
+
+// GetId returns ReviewReplyFieldsDeletedPost.Id, and is useful for accessing the field via an interface.
+func (v *ReviewReplyFieldsDeletedPost) GetId() string { return v.Id }
+
+// SetAppTileResponse is returned by SetAppTile on success.
+type SetAppTileResponse struct {
+	SetPublicAppTileDraftModuleSource SetAppTileSetPublicAppTileDraftModuleSourceSetAppTileDraftModuleSourceResponse `json:"setPublicAppTileDraftModuleSource"`
+}
+
+// GetSetPublicAppTileDraftModuleSource returns SetAppTileResponse.SetPublicAppTileDraftModuleSource, and is useful for accessing the field via an interface.
+func (v *SetAppTileResponse) GetSetPublicAppTileDraftModuleSource() SetAppTileSetPublicAppTileDraftModuleSourceSetAppTileDraftModuleSourceResponse {
+	return v.SetPublicAppTileDraftModuleSource
+}
+
+// SetAppTileSetPublicAppTileDraftModuleSourceSetAppTileDraftModuleSourceResponse includes the requested fields of the GraphQL type SetAppTileDraftModuleSourceResponse.
+type SetAppTileSetPublicAppTileDraftModuleSourceSetAppTileDraftModuleSourceResponse struct {
+	ModuleId string `json:"moduleId"`
+}
+
+// GetModuleId returns SetAppTileSetPublicAppTileDraftModuleSourceSetAppTileDraftModuleSourceResponse.ModuleId, and is useful for accessing the field via an interface.
+func (v *SetAppTileSetPublicAppTileDraftModuleSourceSetAppTileDraftModuleSourceResponse) GetModuleId() string {
+	return v.ModuleId
+}
+
+type SetPublicAppTileDraftModuleSourceInput struct {
+	ModuleId   string                        `json:"moduleId"`
+	SourceInfo PublicAppTileModuleSourceInfo `json:"sourceInfo"`
+}
+
+// GetModuleId returns SetPublicAppTileDraftModuleSourceInput.ModuleId, and is useful for accessing the field via an interface.
+func (v *SetPublicAppTileDraftModuleSourceInput) GetModuleId() string { return v.ModuleId }
+
+// GetSourceInfo returns SetPublicAppTileDraftModuleSourceInput.SourceInfo, and is useful for accessing the field via an interface.
+func (v *SetPublicAppTileDraftModuleSourceInput) GetSourceInfo() PublicAppTileModuleSourceInfo {
+	return v.SourceInfo
+}
+
+type SortOrder string
+
+const (
+	SortOrderAsc  SortOrder = "ASC"
+	SortOrderDesc SortOrder = "DESC"
+)
+
+// StartImageUploadResponse is returned by StartImageUpload on success.
+type StartImageUploadResponse struct {
+	StartUpload StartImageUploadStartUploadStartUploadResponse `json:"startUpload"`
+}
+
+// GetStartUpload returns StartImageUploadResponse.StartUpload, and is useful for accessing the field via an interface.
+func (v *StartImageUploadResponse) GetStartUpload() StartImageUploadStartUploadStartUploadResponse {
+	return v.StartUpload
+}
+
+// StartImageUploadStartUploadStartUploadResponse includes the requested fields of the GraphQL type StartUploadResponse.
+type StartImageUploadStartUploadStartUploadResponse struct {
+	Id     string            `json:"id"`
+	Url    string            `json:"url"`
+	Fields map[string]string `json:"fields"`
+}
+
+// GetId returns StartImageUploadStartUploadStartUploadResponse.Id, and is useful for accessing the field via an interface.
+func (v *StartImageUploadStartUploadStartUploadResponse) GetId() string { return v.Id }
+
+// GetUrl returns StartImageUploadStartUploadStartUploadResponse.Url, and is useful for accessing the field via an interface.
+func (v *StartImageUploadStartUploadStartUploadResponse) GetUrl() string { return v.Url }
+
+// GetFields returns StartImageUploadStartUploadStartUploadResponse.Fields, and is useful for accessing the field via an interface.
+func (v *StartImageUploadStartUploadStartUploadResponse) GetFields() map[string]string {
+	return v.Fields
+}
+
+type StartUploadInput struct {
+	FileName string `json:"fileName"`
+}
+
+// GetFileName returns StartUploadInput.FileName, and is useful for accessing the field via an interface.
+func (v *StartUploadInput) GetFileName() string { return v.FileName }
+
+type UpdateMarketplaceReviewReplyInput_v2 struct {
+	Comment  string `json:"comment"`
+	ModuleId string `json:"moduleId"`
+	RatingId string `json:"ratingId"`
+	ReplyId  string `json:"replyId"`
+}
+
+// GetComment returns UpdateMarketplaceReviewReplyInput_v2.Comment, and is useful for accessing the field via an interface.
+func (v *UpdateMarketplaceReviewReplyInput_v2) GetComment() string { return v.Comment }
+
+// GetModuleId returns UpdateMarketplaceReviewReplyInput_v2.ModuleId, and is useful for accessing the field via an interface.
+func (v *UpdateMarketplaceReviewReplyInput_v2) GetModuleId() string { return v.ModuleId }
+
+// GetRatingId returns UpdateMarketplaceReviewReplyInput_v2.RatingId, and is useful for accessing the field via an interface.
+func (v *UpdateMarketplaceReviewReplyInput_v2) GetRatingId() string { return v.RatingId }
+
+// GetReplyId returns UpdateMarketplaceReviewReplyInput_v2.ReplyId, and is useful for accessing the field via an interface.
+func (v *UpdateMarketplaceReviewReplyInput_v2) GetReplyId() string { return v.ReplyId }
+
+// UpdateMarketplaceReviewReplyResponse is returned by UpdateMarketplaceReviewReply on success.
+type UpdateMarketplaceReviewReplyResponse struct {
+	UpdateMarketplaceReviewReply_v2 UpdateMarketplaceReviewReplyUpdateMarketplaceReviewReply_v2Review `json:"updateMarketplaceReviewReply_v2"`
+}
+
+// GetUpdateMarketplaceReviewReply_v2 returns UpdateMarketplaceReviewReplyResponse.UpdateMarketplaceReviewReply_v2, and is useful for accessing the field via an interface.
+func (v *UpdateMarketplaceReviewReplyResponse) GetUpdateMarketplaceReviewReply_v2() UpdateMarketplaceReviewReplyUpdateMarketplaceReviewReply_v2Review {
+	return v.UpdateMarketplaceReviewReply_v2
+}
+
+// UpdateMarketplaceReviewReplyUpdateMarketplaceReviewReply_v2Review includes the requested fields of the GraphQL type Review.
+type UpdateMarketplaceReviewReplyUpdateMarketplaceReviewReply_v2Review struct {
+	Id string `json:"id"`
+}
+
+// GetId returns UpdateMarketplaceReviewReplyUpdateMarketplaceReviewReply_v2Review.Id, and is useful for accessing the field via an interface.
+func (v *UpdateMarketplaceReviewReplyUpdateMarketplaceReviewReply_v2Review) GetId() string {
+	return v.Id
+}
+
+type UploadType string
+
+const (
+	UploadTypeIcon         UploadType = "ICON"
+	UploadTypePreviewImage UploadType = "PREVIEW_IMAGE"
+)
+
+// __ApproveModulePublishInput is used internally by genqlient
+type __ApproveModulePublishInput struct {
+	Input ApproveModulePublishInput `json:"input"`
+}
+
+// GetInput returns __ApproveModulePublishInput.Input, and is useful for accessing the field via an interface.
+func (v *__ApproveModulePublishInput) GetInput() ApproveModulePublishInput { return v.Input }
+
+// __AssignDraftModuleForReviewInput is used internally by genqlient
+type __AssignDraftModuleForReviewInput struct {
+	ModuleId string `json:"moduleId"`
+}
+
+// GetModuleId returns __AssignDraftModuleForReviewInput.ModuleId, and is useful for accessing the field via an interface.
+func (v *__AssignDraftModuleForReviewInput) GetModuleId() string { return v.ModuleId }
+
+// __CancelModulePublishInput is used internally by genqlient
+type __CancelModulePublishInput struct {
+	ModuleId string `json:"moduleId"`
+}
+
+// GetModuleId returns __CancelModulePublishInput.ModuleId, and is useful for accessing the field via an interface.
+func (v *__CancelModulePublishInput) GetModuleId() string { return v.ModuleId }
+
+// __CreateDraftModuleInput is used internally by genqlient
+type __CreateDraftModuleInput struct {
+	Input CreateDraftModuleInput `json:"input"`
+}
+
+// GetInput returns __CreateDraftModuleInput.Input, and is useful for accessing the field via an interface.
+func (v *__CreateDraftModuleInput) GetInput() CreateDraftModuleInput { return v.Input }
+
+// __CreateMarketplaceReviewReplyInput is used internally by genqlient
+type __CreateMarketplaceReviewReplyInput struct {
+	Input CreateMarketplaceReviewReplyInput `json:"input"`
+}
+
+// GetInput returns __CreateMarketplaceReviewReplyInput.Input, and is useful for accessing the field via an interface.
+func (v *__CreateMarketplaceReviewReplyInput) GetInput() CreateMarketplaceReviewReplyInput {
+	return v.Input
+}
+
+// __DeleteDraftModuleInput is used internally by genqlient
+type __DeleteDraftModuleInput struct {
+	ModuleId string `json:"moduleId"`
+}
+
+// GetModuleId returns __DeleteDraftModuleInput.ModuleId, and is useful for accessing the field via an interface.
+func (v *__DeleteDraftModuleInput) GetModuleId() string { return v.ModuleId }
+
+// __DeleteModuleInput is used internally by genqlient
+type __DeleteModuleInput struct {
+	Input DeleteModuleInput `json:"input"`
+}
+
+// GetInput returns __DeleteModuleInput.Input, and is useful for accessing the field via an interface.
+func (v *__DeleteModuleInput) GetInput() DeleteModuleInput { return v.Input }
+
+// __DenyModulePublishInput is used internally by genqlient
+type __DenyModulePublishInput struct {
+	Input DenyModulePublishInput `json:"input"`
+}
+
+// GetInput returns __DenyModulePublishInput.Input, and is useful for accessing the field via an interface.
+func (v *__DenyModulePublishInput) GetInput() DenyModulePublishInput { return v.Input }
+
+// __FinalizeImageUploadInput is used internally by genqlient
+type __FinalizeImageUploadInput struct {
+	Input FinalizeUploadInput `json:"input"`
+}
+
+// GetInput returns __FinalizeImageUploadInput.Input, and is useful for accessing the field via an interface.
+func (v *__FinalizeImageUploadInput) GetInput() FinalizeUploadInput { return v.Input }
+
+// __GetBillingPortalLinkInput is used internally by genqlient
+type __GetBillingPortalLinkInput struct {
+	Input GetBillingPortalLinkInput `json:"input"`
+}
+
+// GetInput returns __GetBillingPortalLinkInput.Input, and is useful for accessing the field via an interface.
+func (v *__GetBillingPortalLinkInput) GetInput() GetBillingPortalLinkInput { return v.Input }
+
+// __GetConnectAccountLinkInput is used internally by genqlient
+type __GetConnectAccountLinkInput struct {
+	Input GetConnectAccountLinkInput `json:"input"`
+}
+
+// GetInput returns __GetConnectAccountLinkInput.Input, and is useful for accessing the field via an interface.
+func (v *__GetConnectAccountLinkInput) GetInput() GetConnectAccountLinkInput { return v.Input }
+
+// __GetDraftModuleInput is used internally by genqlient
+type __GetDraftModuleInput struct {
+	ModuleId string `json:"moduleId"`
+}
+
+// GetModuleId returns __GetDraftModuleInput.ModuleId, and is useful for accessing the field via an interface.
+func (v *__GetDraftModuleInput) GetModuleId() string { return v.ModuleId }
+
+// __GetDraftModulePreviewImagesInput is used internally by genqlient
+type __GetDraftModulePreviewImagesInput struct {
+	ModuleId string `json:"moduleId"`
+}
+
+// GetModuleId returns __GetDraftModulePreviewImagesInput.ModuleId, and is useful for accessing the field via an interface.
+func (v *__GetDraftModulePreviewImagesInput) GetModuleId() string { return v.ModuleId }
+
+// __GetDraftModulesInManualReviewInput is used internally by genqlient
+type __GetDraftModulesInManualReviewInput struct {
+	After string                          `json:"after,omitempty"`
+	First int                             `json:"first"`
+	Input DraftModulesInManualReviewInput `json:"input"`
+}
+
+// GetAfter returns __GetDraftModulesInManualReviewInput.After, and is useful for accessing the field via an interface.
+func (v *__GetDraftModulesInManualReviewInput) GetAfter() string { return v.After }
+
+// GetFirst returns __GetDraftModulesInManualReviewInput.First, and is useful for accessing the field via an interface.
+func (v *__GetDraftModulesInManualReviewInput) GetFirst() int { return v.First }
+
+// GetInput returns __GetDraftModulesInManualReviewInput.Input, and is useful for accessing the field via an interface.
+func (v *__GetDraftModulesInManualReviewInput) GetInput() DraftModulesInManualReviewInput {
+	return v.Input
+}
+
+// __GetModuleCategoryInput is used internally by genqlient
+type __GetModuleCategoryInput struct {
+	Id      string `json:"id"`
+	Version string `json:"version"`
+}
+
+// GetId returns __GetModuleCategoryInput.Id, and is useful for accessing the field via an interface.
+func (v *__GetModuleCategoryInput) GetId() string { return v.Id }
+
+// GetVersion returns __GetModuleCategoryInput.Version, and is useful for accessing the field via an interface.
+func (v *__GetModuleCategoryInput) GetVersion() string { return v.Version }
+
+// __GetModulePublishReviewInput is used internally by genqlient
+type __GetModulePublishReviewInput struct {
+	Id       string `json:"id"`
+	ModuleId string `json:"moduleId"`
+}
+
+// GetId returns __GetModulePublishReviewInput.Id, and is useful for accessing the field via an interface.
+func (v *__GetModulePublishReviewInput) GetId() string { return v.Id }
+
+// GetModuleId returns __GetModulePublishReviewInput.ModuleId, and is useful for accessing the field via an interface.
+func (v *__GetModulePublishReviewInput) GetModuleId() string { return v.ModuleId }
+
+// __GetModulePublishReviewsInput is used internally by genqlient
+type __GetModulePublishReviewsInput struct {
+	ModuleId string `json:"moduleId"`
+	After    string `json:"after,omitempty"`
+	First    int    `json:"first"`
+}
+
+// GetModuleId returns __GetModulePublishReviewsInput.ModuleId, and is useful for accessing the field via an interface.
+func (v *__GetModulePublishReviewsInput) GetModuleId() string { return v.ModuleId }
+
+// GetAfter returns __GetModulePublishReviewsInput.After, and is useful for accessing the field via an interface.
+func (v *__GetModulePublishReviewsInput) GetAfter() string { return v.After }
+
+// GetFirst returns __GetModulePublishReviewsInput.First, and is useful for accessing the field via an interface.
+func (v *__GetModulePublishReviewsInput) GetFirst() int { return v.First }
+
+// __GetModuleRatingInput is used internally by genqlient
+type __GetModuleRatingInput struct {
+	Id      string `json:"id"`
+	Version string `json:"version,omitempty"`
+}
+
+// GetId returns __GetModuleRatingInput.Id, and is useful for accessing the field via an interface.
+func (v *__GetModuleRatingInput) GetId() string { return v.Id }
+
+// GetVersion returns __GetModuleRatingInput.Version, and is useful for accessing the field via an interface.
+func (v *__GetModuleRatingInput) GetVersion() string { return v.Version }
+
+// __GetModuleReviewsInput is used internally by genqlient
+type __GetModuleReviewsInput struct {
+	Id    string `json:"id"`
+	After string `json:"after,omitempty"`
+	First int    `json:"first"`
+}
+
+// GetId returns __GetModuleReviewsInput.Id, and is useful for accessing the field via an interface.
+func (v *__GetModuleReviewsInput) GetId() string { return v.Id }
+
+// GetAfter returns __GetModuleReviewsInput.After, and is useful for accessing the field via an interface.
+func (v *__GetModuleReviewsInput) GetAfter() string { return v.After }
+
+// GetFirst returns __GetModuleReviewsInput.First, and is useful for accessing the field via an interface.
+func (v *__GetModuleReviewsInput) GetFirst() int { return v.First }
+
+// __GetModuleStatsInput is used internally by genqlient
+type __GetModuleStatsInput struct {
+	Input ModuleStatsInput `json:"input"`
+}
+
+// GetInput returns __GetModuleStatsInput.Input, and is useful for accessing the field via an interface.
+func (v *__GetModuleStatsInput) GetInput() ModuleStatsInput { return v.Input }
+
+// __GetModuleTagsInput is used internally by genqlient
+type __GetModuleTagsInput struct {
+	After string          `json:"after,omitempty"`
+	First int             `json:"first"`
+	Input ModuleTagsInput `json:"input"`
+}
+
+// GetAfter returns __GetModuleTagsInput.After, and is useful for accessing the field via an interface.
+func (v *__GetModuleTagsInput) GetAfter() string { return v.After }
+
+// GetFirst returns __GetModuleTagsInput.First, and is useful for accessing the field via an interface.
+func (v *__GetModuleTagsInput) GetFirst() int { return v.First }
+
+// GetInput returns __GetModuleTagsInput.Input, and is useful for accessing the field via an interface.
+func (v *__GetModuleTagsInput) GetInput() ModuleTagsInput { return v.Input }
+
+// __GetModuleVersionsInput is used internally by genqlient
+type __GetModuleVersionsInput struct {
+	Id    string `json:"id"`
+	After string `json:"after,omitempty"`
+	First int    `json:"first"`
+}
+
+// GetId returns __GetModuleVersionsInput.Id, and is useful for accessing the field via an interface.
+func (v *__GetModuleVersionsInput) GetId() string { return v.Id }
+
+// GetAfter returns __GetModuleVersionsInput.After, and is useful for accessing the field via an interface.
+func (v *__GetModuleVersionsInput) GetAfter() string { return v.After }
+
+// GetFirst returns __GetModuleVersionsInput.First, and is useful for accessing the field via an interface.
+func (v *__GetModuleVersionsInput) GetFirst() int { return v.First }
+
+// __GetMyInstallInput is used internally by genqlient
+type __GetMyInstallInput struct {
+	InstallId   string `json:"installId"`
+	InstalledOn int64  `json:"installedOn"`
+}
+
+// GetInstallId returns __GetMyInstallInput.InstallId, and is useful for accessing the field via an interface.
+func (v *__GetMyInstallInput) GetInstallId() string { return v.InstallId }
+
+// GetInstalledOn returns __GetMyInstallInput.InstalledOn, and is useful for accessing the field via an interface.
+func (v *__GetMyInstallInput) GetInstalledOn() int64 { return v.InstalledOn }
+
+// __GetMyInstallsInput is used internally by genqlient
+type __GetMyInstallsInput struct {
+	After string        `json:"after,omitempty"`
+	First int           `json:"first"`
+	Input InstallsInput `json:"input"`
+	Sort  SortOrder     `json:"sort,omitempty"`
+}
+
+// GetAfter returns __GetMyInstallsInput.After, and is useful for accessing the field via an interface.
+func (v *__GetMyInstallsInput) GetAfter() string { return v.After }
+
+// GetFirst returns __GetMyInstallsInput.First, and is useful for accessing the field via an interface.
+func (v *__GetMyInstallsInput) GetFirst() int { return v.First }
+
+// GetInput returns __GetMyInstallsInput.Input, and is useful for accessing the field via an interface.
+func (v *__GetMyInstallsInput) GetInput() InstallsInput { return v.Input }
+
+// GetSort returns __GetMyInstallsInput.Sort, and is useful for accessing the field via an interface.
+func (v *__GetMyInstallsInput) GetSort() SortOrder { return v.Sort }
+
+// __GetMyPurchasesInput is used internally by genqlient
+type __GetMyPurchasesInput struct {
+	After        string         `json:"after,omitempty"`
+	First        int            `json:"first"`
+	Status       PurchaseStatus `json:"status,omitempty"`
+	PurchaseType PurchaseType   `json:"purchaseType,omitempty"`
+}
+
+// GetAfter returns __GetMyPurchasesInput.After, and is useful for accessing the field via an interface.
+func (v *__GetMyPurchasesInput) GetAfter() string { return v.After }
+
+// GetFirst returns __GetMyPurchasesInput.First, and is useful for accessing the field via an interface.
+func (v *__GetMyPurchasesInput) GetFirst() int { return v.First }
+
+// GetStatus returns __GetMyPurchasesInput.Status, and is useful for accessing the field via an interface.
+func (v *__GetMyPurchasesInput) GetStatus() PurchaseStatus { return v.Status }
+
+// GetPurchaseType returns __GetMyPurchasesInput.PurchaseType, and is useful for accessing the field via an interface.
+func (v *__GetMyPurchasesInput) GetPurchaseType() PurchaseType { return v.PurchaseType }
+
+// __GetOrgInstallInput is used internally by genqlient
+type __GetOrgInstallInput struct {
+	InstallId   string `json:"installId"`
+	InstalledOn int64  `json:"installedOn"`
+}
+
+// GetInstallId returns __GetOrgInstallInput.InstallId, and is useful for accessing the field via an interface.
+func (v *__GetOrgInstallInput) GetInstallId() string { return v.InstallId }
+
+// GetInstalledOn returns __GetOrgInstallInput.InstalledOn, and is useful for accessing the field via an interface.
+func (v *__GetOrgInstallInput) GetInstalledOn() int64 { return v.InstalledOn }
+
+// __GetOrgInstallsInput is used internally by genqlient
+type __GetOrgInstallsInput struct {
+	After string        `json:"after,omitempty"`
+	First int           `json:"first"`
+	Input InstallsInput `json:"input"`
+	Sort  SortOrder     `json:"sort,omitempty"`
+}
+
+// GetAfter returns __GetOrgInstallsInput.After, and is useful for accessing the field via an interface.
+func (v *__GetOrgInstallsInput) GetAfter() string { return v.After }
+
+// GetFirst returns __GetOrgInstallsInput.First, and is useful for accessing the field via an interface.
+func (v *__GetOrgInstallsInput) GetFirst() int { return v.First }
+
+// GetInput returns __GetOrgInstallsInput.Input, and is useful for accessing the field via an interface.
+func (v *__GetOrgInstallsInput) GetInput() InstallsInput { return v.Input }
+
+// GetSort returns __GetOrgInstallsInput.Sort, and is useful for accessing the field via an interface.
+func (v *__GetOrgInstallsInput) GetSort() SortOrder { return v.Sort }
+
+// __GetOrgModuleCategoryInput is used internally by genqlient
+type __GetOrgModuleCategoryInput struct {
+	Id      string `json:"id"`
+	Version string `json:"version"`
+}
+
+// GetId returns __GetOrgModuleCategoryInput.Id, and is useful for accessing the field via an interface.
+func (v *__GetOrgModuleCategoryInput) GetId() string { return v.Id }
+
+// GetVersion returns __GetOrgModuleCategoryInput.Version, and is useful for accessing the field via an interface.
+func (v *__GetOrgModuleCategoryInput) GetVersion() string { return v.Version }
+
+// __GetOrgModuleTagsInput is used internally by genqlient
+type __GetOrgModuleTagsInput struct {
+	After string             `json:"after,omitempty"`
+	First int                `json:"first"`
+	Input OrgModuleTagsInput `json:"input"`
+}
+
+// GetAfter returns __GetOrgModuleTagsInput.After, and is useful for accessing the field via an interface.
+func (v *__GetOrgModuleTagsInput) GetAfter() string { return v.After }
+
+// GetFirst returns __GetOrgModuleTagsInput.First, and is useful for accessing the field via an interface.
+func (v *__GetOrgModuleTagsInput) GetFirst() int { return v.First }
+
+// GetInput returns __GetOrgModuleTagsInput.Input, and is useful for accessing the field via an interface.
+func (v *__GetOrgModuleTagsInput) GetInput() OrgModuleTagsInput { return v.Input }
+
+// __GetPublishedModuleInput is used internally by genqlient
+type __GetPublishedModuleInput struct {
+	Id      string `json:"id"`
+	Version string `json:"version"`
+}
+
+// GetId returns __GetPublishedModuleInput.Id, and is useful for accessing the field via an interface.
+func (v *__GetPublishedModuleInput) GetId() string { return v.Id }
+
+// GetVersion returns __GetPublishedModuleInput.Version, and is useful for accessing the field via an interface.
+func (v *__GetPublishedModuleInput) GetVersion() string { return v.Version }
+
+// __GetReviewRepliesInput is used internally by genqlient
+type __GetReviewRepliesInput struct {
+	Id           string `json:"id"`
+	After        string `json:"after,omitempty"`
+	First        int    `json:"first"`
+	RepliesAfter string `json:"repliesAfter,omitempty"`
+	RepliesFirst int    `json:"repliesFirst"`
+}
+
+// GetId returns __GetReviewRepliesInput.Id, and is useful for accessing the field via an interface.
+func (v *__GetReviewRepliesInput) GetId() string { return v.Id }
+
+// GetAfter returns __GetReviewRepliesInput.After, and is useful for accessing the field via an interface.
+func (v *__GetReviewRepliesInput) GetAfter() string { return v.After }
+
+// GetFirst returns __GetReviewRepliesInput.First, and is useful for accessing the field via an interface.
+func (v *__GetReviewRepliesInput) GetFirst() int { return v.First }
+
+// GetRepliesAfter returns __GetReviewRepliesInput.RepliesAfter, and is useful for accessing the field via an interface.
+func (v *__GetReviewRepliesInput) GetRepliesAfter() string { return v.RepliesAfter }
+
+// GetRepliesFirst returns __GetReviewRepliesInput.RepliesFirst, and is useful for accessing the field via an interface.
+func (v *__GetReviewRepliesInput) GetRepliesFirst() int { return v.RepliesFirst }
+
+// __GetUserPurchasedModuleInput is used internally by genqlient
+type __GetUserPurchasedModuleInput struct {
+	ModuleId string `json:"moduleId"`
+}
+
+// GetModuleId returns __GetUserPurchasedModuleInput.ModuleId, and is useful for accessing the field via an interface.
+func (v *__GetUserPurchasedModuleInput) GetModuleId() string { return v.ModuleId }
+
+// __GetWellnessOfferingSourceInput is used internally by genqlient
+type __GetWellnessOfferingSourceInput struct {
+	Id      string `json:"id"`
+	Version string `json:"version"`
+}
+
+// GetId returns __GetWellnessOfferingSourceInput.Id, and is useful for accessing the field via an interface.
+func (v *__GetWellnessOfferingSourceInput) GetId() string { return v.Id }
+
+// GetVersion returns __GetWellnessOfferingSourceInput.Version, and is useful for accessing the field via an interface.
+func (v *__GetWellnessOfferingSourceInput) GetVersion() string { return v.Version }
+
+// __InstallConsentModuleInput is used internally by genqlient
+type __InstallConsentModuleInput struct {
+	Input InstallConsentModuleInput `json:"input"`
+}
+
+// GetInput returns __InstallConsentModuleInput.Input, and is useful for accessing the field via an interface.
+func (v *__InstallConsentModuleInput) GetInput() InstallConsentModuleInput { return v.Input }
+
+// __InstallDomainOntologyModuleInput is used internally by genqlient
+type __InstallDomainOntologyModuleInput struct {
+	Input InstallDomainOntologyModuleInput `json:"input"`
+}
+
+// GetInput returns __InstallDomainOntologyModuleInput.Input, and is useful for accessing the field via an interface.
+func (v *__InstallDomainOntologyModuleInput) GetInput() InstallDomainOntologyModuleInput {
+	return v.Input
+}
+
+// __InstallInsightsLayoutModuleInput is used internally by genqlient
+type __InstallInsightsLayoutModuleInput struct {
+	Input InstallInsightsLayoutModuleInput `json:"input"`
+}
+
+// GetInput returns __InstallInsightsLayoutModuleInput.Input, and is useful for accessing the field via an interface.
+func (v *__InstallInsightsLayoutModuleInput) GetInput() InstallInsightsLayoutModuleInput {
+	return v.Input
+}
+
+// __InstallNotebookModuleInput is used internally by genqlient
+type __InstallNotebookModuleInput struct {
+	Input InstallNotebookModuleInput `json:"input"`
+}
+
+// GetInput returns __InstallNotebookModuleInput.Input, and is useful for accessing the field via an interface.
+func (v *__InstallNotebookModuleInput) GetInput() InstallNotebookModuleInput { return v.Input }
+
+// __InstallPatientLayoutModuleInput is used internally by genqlient
+type __InstallPatientLayoutModuleInput struct {
+	Input InstallPatientLayoutModuleInput `json:"input"`
+}
+
+// GetInput returns __InstallPatientLayoutModuleInput.Input, and is useful for accessing the field via an interface.
+func (v *__InstallPatientLayoutModuleInput) GetInput() InstallPatientLayoutModuleInput {
+	return v.Input
+}
+
+// __InstallProcessOntologyModuleInput is used internally by genqlient
+type __InstallProcessOntologyModuleInput struct {
+	Input InstallProcessOntologyModuleInput `json:"input"`
+}
+
+// GetInput returns __InstallProcessOntologyModuleInput.Input, and is useful for accessing the field via an interface.
+func (v *__InstallProcessOntologyModuleInput) GetInput() InstallProcessOntologyModuleInput {
+	return v.Input
+}
+
+// __InstallProgramEnrollmentModuleInput is used internally by genqlient
+type __InstallProgramEnrollmentModuleInput struct {
+	Input InstallProgramEnrollmentModuleInput `json:"input"`
+}
+
+// GetInput returns __InstallProgramEnrollmentModuleInput.Input, and is useful for accessing the field via an interface.
+func (v *__InstallProgramEnrollmentModuleInput) GetInput() InstallProgramEnrollmentModuleInput {
+	return v.Input
+}
+
+// __InstallProgramTemplateModuleInput is used internally by genqlient
+type __InstallProgramTemplateModuleInput struct {
+	Input InstallProgramTemplateModuleInput `json:"input"`
+}
+
+// GetInput returns __InstallProgramTemplateModuleInput.Input, and is useful for accessing the field via an interface.
+func (v *__InstallProgramTemplateModuleInput) GetInput() InstallProgramTemplateModuleInput {
+	return v.Input
+}
+
+// __InstallPublicAppTileModuleInput is used internally by genqlient
+type __InstallPublicAppTileModuleInput struct {
+	Input InstallPublicAppTileModuleInput `json:"input"`
+}
+
+// GetInput returns __InstallPublicAppTileModuleInput.Input, and is useful for accessing the field via an interface.
+func (v *__InstallPublicAppTileModuleInput) GetInput() InstallPublicAppTileModuleInput {
+	return v.Input
+}
+
+// __InstallReportExtractorModuleInput is used internally by genqlient
+type __InstallReportExtractorModuleInput struct {
+	Input InstallReportExtractorModuleInput `json:"input"`
+}
+
+// GetInput returns __InstallReportExtractorModuleInput.Input, and is useful for accessing the field via an interface.
+func (v *__InstallReportExtractorModuleInput) GetInput() InstallReportExtractorModuleInput {
+	return v.Input
+}
+
+// __InstallSearchLayoutModuleInput is used internally by genqlient
+type __InstallSearchLayoutModuleInput struct {
+	Input InstallSearchLayoutModuleInput `json:"input"`
+}
+
+// GetInput returns __InstallSearchLayoutModuleInput.Input, and is useful for accessing the field via an interface.
+func (v *__InstallSearchLayoutModuleInput) GetInput() InstallSearchLayoutModuleInput { return v.Input }
+
+// __InstallSurveyModuleInput is used internally by genqlient
+type __InstallSurveyModuleInput struct {
+	Input InstallSurveyModuleInput `json:"input"`
+}
+
+// GetInput returns __InstallSurveyModuleInput.Input, and is useful for accessing the field via an interface.
+func (v *__InstallSurveyModuleInput) GetInput() InstallSurveyModuleInput { return v.Input }
+
+// __InstallWellnessOfferingModuleInput is used internally by genqlient
+type __InstallWellnessOfferingModuleInput struct {
+	Input InstallWellnessOfferingModuleInput `json:"input"`
+}
+
+// GetInput returns __InstallWellnessOfferingModuleInput.Input, and is useful for accessing the field via an interface.
+func (v *__InstallWellnessOfferingModuleInput) GetInput() InstallWellnessOfferingModuleInput {
+	return v.Input
+}
+
+// __InstallWorkflowModuleInput is used internally by genqlient
+type __InstallWorkflowModuleInput struct {
+	Input InstallWorkflowModuleInput `json:"input"`
+}
+
+// GetInput returns __InstallWorkflowModuleInput.Input, and is useful for accessing the field via an interface.
+func (v *__InstallWorkflowModuleInput) GetInput() InstallWorkflowModuleInput { return v.Input }
+
+// __PublishModuleInput is used internally by genqlient
+type __PublishModuleInput struct {
+	Input PublishDraftModuleInputV2 `json:"input"`
+}
+
+// GetInput returns __PublishModuleInput.Input, and is useful for accessing the field via an interface.
+func (v *__PublishModuleInput) GetInput() PublishDraftModuleInputV2 { return v.Input }
+
+// __PublishModuleV3Input is used internally by genqlient
+type __PublishModuleV3Input struct {
+	Input PublishDraftModuleInputV3 `json:"input"`
+}
+
+// GetInput returns __PublishModuleV3Input.Input, and is useful for accessing the field via an interface.
+func (v *__PublishModuleV3Input) GetInput() PublishDraftModuleInputV3 { return v.Input }
+
+// __RemoveDraftModuleIconInput is used internally by genqlient
+type __RemoveDraftModuleIconInput struct {
+	Input RemoveDraftModuleIconV2Input `json:"input"`
+}
+
+// GetInput returns __RemoveDraftModuleIconInput.Input, and is useful for accessing the field via an interface.
+func (v *__RemoveDraftModuleIconInput) GetInput() RemoveDraftModuleIconV2Input { return v.Input }
+
+// __RemoveDraftModulePreviewImageInput is used internally by genqlient
+type __RemoveDraftModulePreviewImageInput struct {
+	Input RemoveDraftModulePreviewImagesV2Input `json:"input"`
+}
+
+// GetInput returns __RemoveDraftModulePreviewImageInput.Input, and is useful for accessing the field via an interface.
+func (v *__RemoveDraftModulePreviewImageInput) GetInput() RemoveDraftModulePreviewImagesV2Input {
+	return v.Input
+}
+
+// __RemoveMarketplaceReviewReplyInput is used internally by genqlient
+type __RemoveMarketplaceReviewReplyInput struct {
+	Input RemoveMarketplaceReviewReplyInput_v2 `json:"input"`
+}
+
+// GetInput returns __RemoveMarketplaceReviewReplyInput.Input, and is useful for accessing the field via an interface.
+func (v *__RemoveMarketplaceReviewReplyInput) GetInput() RemoveMarketplaceReviewReplyInput_v2 {
+	return v.Input
+}
+
+// __SetAppTileInput is used internally by genqlient
+type __SetAppTileInput struct {
+	Input SetPublicAppTileDraftModuleSourceInput `json:"input"`
+}
+
+// GetInput returns __SetAppTileInput.Input, and is useful for accessing the field via an interface.
+func (v *__SetAppTileInput) GetInput() SetPublicAppTileDraftModuleSourceInput { return v.Input }
+
+// __StartImageUploadInput is used internally by genqlient
+type __StartImageUploadInput struct {
+	Input StartUploadInput `json:"input"`
+}
+
+// GetInput returns __StartImageUploadInput.Input, and is useful for accessing the field via an interface.
+func (v *__StartImageUploadInput) GetInput() StartUploadInput { return v.Input }
+
+// __UpdateMarketplaceReviewReplyInput is used internally by genqlient
+type __UpdateMarketplaceReviewReplyInput struct {
+	Input UpdateMarketplaceReviewReplyInput_v2 `json:"input"`
+}
+
+// GetInput returns __UpdateMarketplaceReviewReplyInput.Input, and is useful for accessing the field via an interface.
+func (v *__UpdateMarketplaceReviewReplyInput) GetInput() UpdateMarketplaceReviewReplyInput_v2 {
+	return v.Input
+}
+
+func ApproveModulePublish(
+	ctx context.Context,
+	client graphql.Client,
+	input ApproveModulePublishInput,
+) (*ApproveModulePublishResponse, error) {
+	req := &graphql.Request{
+		OpName: "ApproveModulePublish",
+		Query: `
+mutation ApproveModulePublish ($input: ApproveModulePublishInput!) {
+	approveModulePublish(input: $input) {
+		id
+		version {
+			version
+		}
+	}
+}
+`,
+		Variables: &__ApproveModulePublishInput{
+			Input: input,
+		},
+	}
+	var err error
+
+	var data ApproveModulePublishResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func AssignDraftModuleForReview(
+	ctx context.Context,
+	client graphql.Client,
+	moduleId string,
+) (*AssignDraftModuleForReviewResponse, error) {
+	req := &graphql.Request{
+		OpName: "AssignDraftModuleForReview",
+		Query: `
+mutation AssignDraftModuleForReview ($moduleId: ID!) {
+	assignDraftModuleForReview(moduleId: $moduleId) {
+		moduleId
+		assignedReviewer
+	}
+}
+`,
+		Variables: &__AssignDraftModuleForReviewInput{
+			ModuleId: moduleId,
+		},
+	}
+	var err error
+
+	var data AssignDraftModuleForReviewResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func CancelModulePublish(
+	ctx context.Context,
+	client graphql.Client,
+	moduleId string,
+) (*CancelModulePublishResponse, error) {
+	req := &graphql.Request{
+		OpName: "CancelModulePublish",
+		Query: `
+mutation CancelModulePublish ($moduleId: ID!) {
+	cancelModulePublish(moduleId: $moduleId) {
+		id
+	}
+}
+`,
+		Variables: &__CancelModulePublishInput{
+			ModuleId: moduleId,
+		},
+	}
+	var err error
+
+	var data CancelModulePublishResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func CreateDraftModule(
+	ctx context.Context,
+	client graphql.Client,
+	input CreateDraftModuleInput,
+) (*CreateDraftModuleResponse, error) {
+	req := &graphql.Request{
+		OpName: "CreateDraftModule",
+		Query: `
+mutation CreateDraftModule ($input: CreateDraftModuleInput!) {
+	createDraftModule(input: $input) {
+		id
+	}
+}
+`,
+		Variables: &__CreateDraftModuleInput{
+			Input: input,
+		},
+	}
+	var err error
+
+	var data CreateDraftModuleResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func CreateMarketplaceReviewReply(
+	ctx context.Context,
+	client graphql.Client,
+	input CreateMarketplaceReviewReplyInput,
+) (*CreateMarketplaceReviewReplyResponse, error) {
+	req := &graphql.Request{
+		OpName: "CreateMarketplaceReviewReply",
+		Query: `
+mutation CreateMarketplaceReviewReply ($input: CreateMarketplaceReviewReplyInput!) {
+	createMarketplaceReviewReply(input: $input) {
+		... ReviewFields
+	}
+}
+fragment ReviewFields on Review {
+	id
+	rating
+	displayName
+	entityVersion
+	createdAt
+	userId
+	comment {
+		__typename
+		id
+		... on ActivePost {
+			message
+		}
+		replies(first: 100) {
+			edges {
+				node {
+					__typename
+					... ReviewReplyFields
+				}
+			}
+			pageInfo {
+				endCursor
+				hasNextPage
+			}
+		}
+	}
+}
+fragment ReviewReplyFields on Post {
+	id
+	... on ActivePost {
+		message
+		authorId
+	}
+}
+`,
+		Variables: &__CreateMarketplaceReviewReplyInput{
+			Input: input,
+		},
+	}
+	var err error
+
+	var data CreateMarketplaceReviewReplyResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func DeleteDraftModule(
+	ctx context.Context,
+	client graphql.Client,
+	moduleId string,
+) (*DeleteDraftModuleResponse, error) {
+	req := &graphql.Request{
+		OpName: "DeleteDraftModule",
+		Query: `
+mutation DeleteDraftModule ($moduleId: ID!) {
+	deleteDraftModule(moduleId: $moduleId) {
+		id
+	}
+}
+`,
+		Variables: &__DeleteDraftModuleInput{
+			ModuleId: moduleId,
+		},
+	}
+	var err error
+
+	var data DeleteDraftModuleResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func DeleteModule(
+	ctx context.Context,
+	client graphql.Client,
+	input DeleteModuleInput,
+) (*DeleteModuleResponse, error) {
+	req := &graphql.Request{
+		OpName: "DeleteModule",
+		Query: `
+mutation DeleteModule ($input: DeleteModuleInput!) {
+	deleteModule(input: $input) {
+		id
+	}
+}
+`,
+		Variables: &__DeleteModuleInput{
+			Input: input,
+		},
+	}
+	var err error
+
+	var data DeleteModuleResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func DenyModulePublish(
+	ctx context.Context,
+	client graphql.Client,
+	input DenyModulePublishInput,
+) (*DenyModulePublishResponse, error) {
+	req := &graphql.Request{
+		OpName: "DenyModulePublish",
+		Query: `
+mutation DenyModulePublish ($input: DenyModulePublishInput!) {
+	denyModulePublish(input: $input) {
+		id
+	}
+}
+`,
+		Variables: &__DenyModulePublishInput{
+			Input: input,
+		},
+	}
+	var err error
+
+	var data DenyModulePublishResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func FinalizeImageUpload(
+	ctx context.Context,
+	client graphql.Client,
+	input FinalizeUploadInput,
+) (*FinalizeImageUploadResponse, error) {
+	req := &graphql.Request{
+		OpName: "FinalizeImageUpload",
+		Query: `
+mutation FinalizeImageUpload ($input: FinalizeUploadInput!) {
+	finalizeUpload(input: $input) {
+		moduleId
+	}
+}
+`,
+		Variables: &__FinalizeImageUploadInput{
+			Input: input,
+		},
+	}
+	var err error
+
+	var data FinalizeImageUploadResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func GetBillingPortalLink(
+	ctx context.Context,
+	client graphql.Client,
+	input GetBillingPortalLinkInput,
+) (*GetBillingPortalLinkResponse, error) {
+	req := &graphql.Request{
+		OpName: "GetBillingPortalLink",
+		Query: `
+mutation GetBillingPortalLink ($input: GetBillingPortalLinkInput!) {
+	getBillingPortalLink(input: $input) {
+		url
+	}
+}
+`,
+		Variables: &__GetBillingPortalLinkInput{
+			Input: input,
+		},
+	}
+	var err error
+
+	var data GetBillingPortalLinkResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func GetConnectAccount(
+	ctx context.Context,
+	client graphql.Client,
+) (*GetConnectAccountResponse, error) {
+	req := &graphql.Request{
+		OpName: "GetConnectAccount",
+		Query: `
+query GetConnectAccount {
+	connectAccount {
+		status
+	}
+}
+`,
+	}
+	var err error
+
+	var data GetConnectAccountResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func GetConnectAccountLink(
+	ctx context.Context,
+	client graphql.Client,
+	input GetConnectAccountLinkInput,
+) (*GetConnectAccountLinkResponse, error) {
+	req := &graphql.Request{
+		OpName: "GetConnectAccountLink",
+		Query: `
+mutation GetConnectAccountLink ($input: GetConnectAccountLinkInput!) {
+	getConnectAccountLink(input: $input) {
+		url
+	}
+}
+`,
+		Variables: &__GetConnectAccountLinkInput{
+			Input: input,
+		},
+	}
+	var err error
+
+	var data GetConnectAccountLinkResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func GetDraftModule(
+	ctx context.Context,
+	client graphql.Client,
+	moduleId string,
+) (*GetDraftModuleResponse, error) {
+	req := &graphql.Request{
+		OpName: "GetDraftModule",
+		Query: `
+query GetDraftModule ($moduleId: ID!) {
+	draftModule(moduleId: $moduleId) {
+		title
+		description
+		parentModuleId
+	}
+}
+`,
+		Variables: &__GetDraftModuleInput{
+			ModuleId: moduleId,
+		},
+	}
+	var err error
+
+	var data GetDraftModuleResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func GetDraftModulePreviewImages(
+	ctx context.Context,
+	client graphql.Client,
+	moduleId string,
+) (*GetDraftModulePreviewImagesResponse, error) {
+	req := &graphql.Request{
+		OpName: "GetDraftModulePreviewImages",
+		Query: `
+query GetDraftModulePreviewImages ($moduleId: ID!) {
+	draftModule(moduleId: $moduleId) {
+		previewImagesV2 {
+			images {
+				fileName
+				fileExtension
+			}
+		}
+	}
+}
+`,
+		Variables: &__GetDraftModulePreviewImagesInput{
+			ModuleId: moduleId,
+		},
+	}
+	var err error
+
+	var data GetDraftModulePreviewImagesResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func GetDraftModulesInManualReview(
+	ctx context.Context,
+	client graphql.Client,
+	after string,
+	first int,
+	input DraftModulesInManualReviewInput,
+) (*GetDraftModulesInManualReviewResponse, error) {
+	req := &graphql.Request{
+		OpName: "GetDraftModulesInManualReview",
+		Query: `
+query GetDraftModulesInManualReview ($after: String, $first: Int, $input: DraftModulesInManualReviewInput!) {
+	draftModulesInManualReview(after: $after, first: $first, input: $input) {
+		edges {
+			node {
+				... ReviewQueueModule
+			}
+		}
+		pageInfo {
+			endCursor
+			hasNextPage
+		}
+	}
+}
+fragment ReviewQueueModule on DraftMarketplaceModule {
+	id
+	title
+	description
+	category
+	parentModuleId
+	assignedReviewer
+	organization {
+		id
+		name
+	}
+}
+`,
+		Variables: &__GetDraftModulesInManualReviewInput{
+			After: after,
+			First: first,
+			Input: input,
+		},
+	}
+	var err error
+
+	var data GetDraftModulesInManualReviewResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func GetModuleCategory(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+	version string,
+) (*GetModuleCategoryResponse, error) {
+	req := &graphql.Request{
+		OpName: "GetModuleCategory",
+		Query: `
+query GetModuleCategory ($id: ID!, $version: String) {
+	module(moduleId: $id, version: $version) {
+		id
+		category
+	}
+}
+`,
+		Variables: &__GetModuleCategoryInput{
+			Id:      id,
+			Version: version,
+		},
+	}
+	var err error
+
+	var data GetModuleCategoryResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func GetModulePublishReview(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+	moduleId string,
+) (*GetModulePublishReviewResponse, error) {
+	req := &graphql.Request{
+		OpName: "GetModulePublishReview",
+		Query: `
+query GetModulePublishReview ($id: ID!, $moduleId: ID!) {
+	modulePublishReview(id: $id, moduleId: $moduleId) {
+		... PublishReviewFields
+	}
+}
+fragment PublishReviewFields on ModulePublishReview {
+	id
+	moduleId
+	moduleVersion
+	moduleVersionChangelog
+	notes
+	status
+	createdBy
+	created
+}
+`,
+		Variables: &__GetModulePublishReviewInput{
+			Id:       id,
+			ModuleId: moduleId,
+		},
+	}
+	var err error
+
+	var data GetModulePublishReviewResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func GetModulePublishReviews(
+	ctx context.Context,
+	client graphql.Client,
+	moduleId string,
+	after string,
+	first int,
+) (*GetModulePublishReviewsResponse, error) {
+	req := &graphql.Request{
+		OpName: "GetModulePublishReviews",
+		Query: `
+query GetModulePublishReviews ($moduleId: ID!, $after: String, $first: Int) {
+	modulePublishReviews(moduleId: $moduleId, after: $after, first: $first) {
+		edges {
+			node {
+				... PublishReviewFields
+			}
+		}
+		pageInfo {
+			endCursor
+			hasNextPage
+		}
+	}
+}
+fragment PublishReviewFields on ModulePublishReview {
+	id
+	moduleId
+	moduleVersion
+	moduleVersionChangelog
+	notes
+	status
+	createdBy
+	created
+}
+`,
+		Variables: &__GetModulePublishReviewsInput{
+			ModuleId: moduleId,
+			After:    after,
+			First:    first,
+		},
+	}
+	var err error
+
+	var data GetModulePublishReviewsResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func GetModuleRating(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+	version string,
+) (*GetModuleRatingResponse, error) {
+	req := &graphql.Request{
+		OpName: "GetModuleRating",
+		Query: `
+query GetModuleRating ($id: ID!, $version: String) {
+	myModule(moduleId: $id, version: $version) {
+		rating {
+			average
+			count
+		}
+	}
+}
+`,
+		Variables: &__GetModuleRatingInput{
+			Id:      id,
+			Version: version,
+		},
+	}
+	var err error
+
+	var data GetModuleRatingResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func GetModuleReviews(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+	after string,
+	first int,
+) (*GetModuleReviewsResponse, error) {
+	req := &graphql.Request{
+		OpName: "GetModuleReviews",
+		Query: `
+query GetModuleReviews ($id: ID!, $after: String, $first: Int) {
+	myModule(moduleId: $id) {
+		reviews(after: $after, first: $first) {
+			edges {
+				node {
+					... ReviewFields
+				}
+			}
+			pageInfo {
+				endCursor
+				hasNextPage
+			}
+		}
+	}
+}
+fragment ReviewFields on Review {
+	id
+	rating
+	displayName
+	entityVersion
+	createdAt
+	userId
+	comment {
+		__typename
+		id
+		... on ActivePost {
+			message
+		}
+		replies(first: 100) {
+			edges {
+				node {
+					__typename
+					... ReviewReplyFields
+				}
+			}
+			pageInfo {
+				endCursor
+				hasNextPage
+			}
+		}
+	}
+}
+fragment ReviewReplyFields on Post {
+	id
+	... on ActivePost {
+		message
+		authorId
+	}
+}
+`,
+		Variables: &__GetModuleReviewsInput{
+			Id:    id,
+			After: after,
+			First: first,
+		},
+	}
+	var err error
+
+	var data GetModuleReviewsResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func GetModuleStats(
+	ctx context.Context,
+	client graphql.Client,
+	input ModuleStatsInput,
+) (*GetModuleStatsResponse, error) {
+	req := &graphql.Request{
+		OpName: "GetModuleStats",
+		Query: `
+query GetModuleStats ($input: ModuleStatsInput!) {
+	moduleStats(input: $input) {
+		total
+		categories {
+			category
+			total
+		}
+		scopes {
+			scope
+			total
+		}
+		dateRange {
+			from
+			to
+		}
+	}
+}
+`,
+		Variables: &__GetModuleStatsInput{
+			Input: input,
+		},
+	}
+	var err error
+
+	var data GetModuleStatsResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func GetModuleTags(
+	ctx context.Context,
+	client graphql.Client,
+	after string,
+	first int,
+	input ModuleTagsInput,
+) (*GetModuleTagsResponse, error) {
+	req := &graphql.Request{
+		OpName: "GetModuleTags",
+		Query: `
+query GetModuleTags ($after: String, $first: Int, $input: ModuleTagsInput!) {
+	moduleTags(after: $after, first: $first, input: $input) {
+		... ModuleTagPage
+	}
+}
+fragment ModuleTagPage on MarketplaceModuleTagsConnection {
+	edges {
+		node {
+			value
+			count
+		}
+	}
+	pageInfo {
+		endCursor
+		hasNextPage
+	}
+}
+`,
+		Variables: &__GetModuleTagsInput{
+			After: after,
+			First: first,
+			Input: input,
+		},
+	}
+	var err error
+
+	var data GetModuleTagsResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func GetModuleVersions(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+	after string,
+	first int,
+) (*GetModuleVersionsResponse, error) {
+	req := &graphql.Request{
+		OpName: "GetModuleVersions",
+		Query: `
+query GetModuleVersions ($id: ID!, $after: String, $first: Int) {
+	myModule(moduleId: $id) {
+		versionsV2(after: $after, first: $first) {
+			edges {
+				node {
+					... ModuleVersionFields
+				}
+			}
+			pageInfo {
+				endCursor
+				hasNextPage
+			}
+		}
+	}
+}
+fragment ModuleVersionFields on VersionsV2Node {
+	version
+	created
+	changeLog
+}
+`,
+		Variables: &__GetModuleVersionsInput{
+			Id:    id,
+			After: after,
+			First: first,
+		},
+	}
+	var err error
+
+	var data GetModuleVersionsResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func GetMyInstall(
+	ctx context.Context,
+	client graphql.Client,
+	installId string,
+	installedOn int64,
+) (*GetMyInstallResponse, error) {
+	req := &graphql.Request{
+		OpName: "GetMyInstall",
+		Query: `
+query GetMyInstall ($installId: ID!, $installedOn: Long!) {
+	myInstall(installId: $installId, installedOn: $installedOn) {
+		... InstallFields
+	}
+}
+fragment InstallFields on Install {
+	id
+	installedOn
+	module {
+		__typename
+		... on MarketplaceModule {
+			id
+			title
+			version
+			category
+		}
+		... on ModuleDeletedMessage {
+			moduleId
+			message
+		}
+		... on IncorrectScopeMessage {
+			message
+		}
+	}
+}
+`,
+		Variables: &__GetMyInstallInput{
+			InstallId:   installId,
+			InstalledOn: installedOn,
+		},
+	}
+	var err error
+
+	var data GetMyInstallResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func GetMyInstalls(
+	ctx context.Context,
+	client graphql.Client,
+	after string,
+	first int,
+	input InstallsInput,
+	sort SortOrder,
+) (*GetMyInstallsResponse, error) {
+	req := &graphql.Request{
+		OpName: "GetMyInstalls",
+		Query: `
+query GetMyInstalls ($after: String, $first: Int, $input: InstallsInput, $sort: SortOrder) {
+	myInstalls(after: $after, first: $first, input: $input, sort: $sort) {
+		... InstallPage
+	}
+}
+fragment InstallPage on InstallConnection {
+	edges {
+		node {
+			... InstallFields
+		}
+	}
+	pageInfo {
+		endCursor
+		hasNextPage
+	}
+}
+fragment InstallFields on Install {
+	id
+	installedOn
+	module {
+		__typename
+		... on MarketplaceModule {
+			id
+			title
+			version
+			category
+		}
+		... on ModuleDeletedMessage {
+			moduleId
+			message
+		}
+		... on IncorrectScopeMessage {
+			message
+		}
+	}
+}
+`,
+		Variables: &__GetMyInstallsInput{
+			After: after,
+			First: first,
+			Input: input,
+			Sort:  sort,
+		},
+	}
+	var err error
+
+	var data GetMyInstallsResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func GetMyPurchases(
+	ctx context.Context,
+	client graphql.Client,
+	after string,
+	first int,
+	status PurchaseStatus,
+	purchaseType PurchaseType,
+) (*GetMyPurchasesResponse, error) {
+	req := &graphql.Request{
+		OpName: "GetMyPurchases",
+		Query: `
+query GetMyPurchases ($after: String, $first: Int, $status: PurchaseStatus, $purchaseType: PurchaseType) {
+	myPurchases(after: $after, first: $first, status: $status, type: $purchaseType) {
+		edges {
+			node {
+				... PurchaseFields
+			}
+		}
+		pageInfo {
+			endCursor
+			hasNextPage
+		}
+	}
+}
+fragment PurchaseFields on Purchase {
+	purchaseId
+	moduleId
+	status
+	type
+	purchasedAt
+	cancelledAt
+	module {
+		__typename
+		... on MarketplaceModule {
+			id
+			title
+			version
+		}
+		... on ModuleDeletedMessage {
+			moduleId
+			message
+		}
+	}
+}
+`,
+		Variables: &__GetMyPurchasesInput{
+			After:        after,
+			First:        first,
+			Status:       status,
+			PurchaseType: purchaseType,
+		},
+	}
+	var err error
+
+	var data GetMyPurchasesResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func GetOrgInstall(
+	ctx context.Context,
+	client graphql.Client,
+	installId string,
+	installedOn int64,
+) (*GetOrgInstallResponse, error) {
+	req := &graphql.Request{
+		OpName: "GetOrgInstall",
+		Query: `
+query GetOrgInstall ($installId: ID!, $installedOn: Long!) {
+	orgInstall(installId: $installId, installedOn: $installedOn) {
+		... InstallFields
+	}
+}
+fragment InstallFields on Install {
+	id
+	installedOn
+	module {
+		__typename
+		... on MarketplaceModule {
+			id
+			title
+			version
+			category
+		}
+		... on ModuleDeletedMessage {
+			moduleId
+			message
+		}
+		... on IncorrectScopeMessage {
+			message
+		}
+	}
+}
+`,
+		Variables: &__GetOrgInstallInput{
+			InstallId:   installId,
+			InstalledOn: installedOn,
+		},
+	}
+	var err error
+
+	var data GetOrgInstallResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func GetOrgInstalls(
+	ctx context.Context,
+	client graphql.Client,
+	after string,
+	first int,
+	input InstallsInput,
+	sort SortOrder,
+) (*GetOrgInstallsResponse, error) {
+	req := &graphql.Request{
+		OpName: "GetOrgInstalls",
+		Query: `
+query GetOrgInstalls ($after: String, $first: Int, $input: InstallsInput, $sort: SortOrder) {
+	orgInstalls(after: $after, first: $first, input: $input, sort: $sort) {
+		... InstallPage
+	}
+}
+fragment InstallPage on InstallConnection {
+	edges {
+		node {
+			... InstallFields
+		}
+	}
+	pageInfo {
+		endCursor
+		hasNextPage
+	}
+}
+fragment InstallFields on Install {
+	id
+	installedOn
+	module {
+		__typename
+		... on MarketplaceModule {
+			id
+			title
+			version
+			category
+		}
+		... on ModuleDeletedMessage {
+			moduleId
+			message
+		}
+		... on IncorrectScopeMessage {
+			message
+		}
+	}
+}
+`,
+		Variables: &__GetOrgInstallsInput{
+			After: after,
+			First: first,
+			Input: input,
+			Sort:  sort,
+		},
+	}
+	var err error
+
+	var data GetOrgInstallsResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func GetOrgModuleCategory(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+	version string,
+) (*GetOrgModuleCategoryResponse, error) {
+	req := &graphql.Request{
+		OpName: "GetOrgModuleCategory",
+		Query: `
+query GetOrgModuleCategory ($id: ID!, $version: String) {
+	orgModule(moduleId: $id, version: $version) {
+		id
+		category
+	}
+}
+`,
+		Variables: &__GetOrgModuleCategoryInput{
+			Id:      id,
+			Version: version,
+		},
+	}
+	var err error
+
+	var data GetOrgModuleCategoryResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func GetOrgModuleTags(
+	ctx context.Context,
+	client graphql.Client,
+	after string,
+	first int,
+	input OrgModuleTagsInput,
+) (*GetOrgModuleTagsResponse, error) {
+	req := &graphql.Request{
+		OpName: "GetOrgModuleTags",
+		Query: `
+query GetOrgModuleTags ($after: String, $first: Int, $input: OrgModuleTagsInput!) {
+	orgModuleTags(after: $after, first: $first, input: $input) {
+		... ModuleTagPage
+	}
+}
+fragment ModuleTagPage on MarketplaceModuleTagsConnection {
+	edges {
+		node {
+			value
+			count
+		}
+	}
+	pageInfo {
+		endCursor
+		hasNextPage
+	}
+}
+`,
+		Variables: &__GetOrgModuleTagsInput{
+			After: after,
+			First: first,
+			Input: input,
+		},
+	}
+	var err error
+
+	var data GetOrgModuleTagsResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func GetPublishedModule(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+	version string,
+) (*GetPublishedModuleResponse, error) {
+	req := &graphql.Request{
+		OpName: "GetPublishedModule",
+		Query: `
+query GetPublishedModule ($id: ID!, $version: String) {
+	myModule(moduleId: $id, version: $version) {
+		... AppTileModule
+	}
+}
+fragment AppTileModule on MarketplaceModule {
+	title
+	description
+	version
+	tags
+	prices {
+		amount
+		interval
+	}
+	source {
+		__typename
+		... on AppTile {
+			id
+		}
+	}
+	iconV2 {
+		url
+		fileName
+		fileExtension
+	}
+	previewImagesV2 {
+		images {
+			url
+			description
+		}
+	}
+}
+`,
+		Variables: &__GetPublishedModuleInput{
+			Id:      id,
+			Version: version,
+		},
+	}
+	var err error
+
+	var data GetPublishedModuleResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// Pages through the replies to the reviews on one page of a module's reviews
+func GetReviewReplies(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+	after string,
+	first int,
+	repliesAfter string,
+	repliesFirst int,
+) (*GetReviewRepliesResponse, error) {
+	req := &graphql.Request{
+		OpName: "GetReviewReplies",
+		Query: `
+query GetReviewReplies ($id: ID!, $after: String, $first: Int, $repliesAfter: String, $repliesFirst: Int) {
+	myModule(moduleId: $id) {
+		reviews(after: $after, first: $first) {
+			edges {
+				node {
+					id
+					comment {
+						__typename
+						replies(after: $repliesAfter, first: $repliesFirst) {
+							edges {
+								node {
+									__typename
+									... ReviewReplyFields
+								}
+							}
+							pageInfo {
+								endCursor
+								hasNextPage
+							}
+						}
+					}
+				}
+			}
+			pageInfo {
+				endCursor
+				hasNextPage
+			}
+		}
+	}
+}
+fragment ReviewReplyFields on Post {
+	id
+	... on ActivePost {
+		message
+		authorId
+	}
+}
+`,
+		Variables: &__GetReviewRepliesInput{
+			Id:           id,
+			After:        after,
+			First:        first,
+			RepliesAfter: repliesAfter,
+			RepliesFirst: repliesFirst,
+		},
+	}
+	var err error
+
+	var data GetReviewRepliesResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func GetUserPurchasedModule(
+	ctx context.Context,
+	client graphql.Client,
+	moduleId string,
+) (*GetUserPurchasedModuleResponse, error) {
+	req := &graphql.Request{
+		OpName: "GetUserPurchasedModule",
+		Query: `
+query GetUserPurchasedModule ($moduleId: ID!) {
+	userPurchasedModule(moduleId: $moduleId) {
+		purchased
+		purchase {
+			... PurchaseFields
+		}
+	}
+}
+fragment PurchaseFields on Purchase {
+	purchaseId
+	moduleId
+	status
+	type
+	purchasedAt
+	cancelledAt
+	module {
+		__typename
+		... on MarketplaceModule {
+			id
+			title
+			version
+		}
+		... on ModuleDeletedMessage {
+			moduleId
+			message
+		}
+	}
+}
+`,
+		Variables: &__GetUserPurchasedModuleInput{
+			ModuleId: moduleId,
+		},
+	}
+	var err error
+
+	var data GetUserPurchasedModuleResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func GetWellnessOfferingSource(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+	version string,
+) (*GetWellnessOfferingSourceResponse, error) {
+	req := &graphql.Request{
+		OpName: "GetWellnessOfferingSource",
+		Query: `
+query GetWellnessOfferingSource ($id: ID!, $version: String) {
+	module(moduleId: $id, version: $version) {
+		id
+		category
+		source {
+			__typename
+			... on WellnessOffering {
+				id
+				configurationSchema
+			}
+		}
+	}
+}
+`,
+		Variables: &__GetWellnessOfferingSourceInput{
+			Id:      id,
+			Version: version,
+		},
+	}
+	var err error
+
+	var data GetWellnessOfferingSourceResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func InstallConsentModule(
+	ctx context.Context,
+	client graphql.Client,
+	input InstallConsentModuleInput,
+) (*InstallConsentModuleResponse, error) {
+	req := &graphql.Request{
+		OpName: "InstallConsentModule",
+		Query: `
+mutation InstallConsentModule ($input: InstallConsentModuleInput!) {
+	installConsentModule(input: $input) {
+		moduleId
+	}
+}
+`,
+		Variables: &__InstallConsentModuleInput{
+			Input: input,
+		},
+	}
+	var err error
+
+	var data InstallConsentModuleResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func InstallDomainOntologyModule(
+	ctx context.Context,
+	client graphql.Client,
+	input InstallDomainOntologyModuleInput,
+) (*InstallDomainOntologyModuleResponse, error) {
+	req := &graphql.Request{
+		OpName: "InstallDomainOntologyModule",
+		Query: `
+mutation InstallDomainOntologyModule ($input: InstallDomainOntologyModuleInput!) {
+	installDomainOntologyModule(input: $input) {
+		id
+	}
+}
+`,
+		Variables: &__InstallDomainOntologyModuleInput{
+			Input: input,
+		},
+	}
+	var err error
+
+	var data InstallDomainOntologyModuleResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func InstallInsightsLayoutModule(
+	ctx context.Context,
+	client graphql.Client,
+	input InstallInsightsLayoutModuleInput,
+) (*InstallInsightsLayoutModuleResponse, error) {
+	req := &graphql.Request{
+		OpName: "InstallInsightsLayoutModule",
+		Query: `
+mutation InstallInsightsLayoutModule ($input: InstallInsightsLayoutModuleInput!) {
+	installInsightsLayoutModule(input: $input) {
+		moduleId
+	}
+}
+`,
+		Variables: &__InstallInsightsLayoutModuleInput{
+			Input: input,
+		},
+	}
+	var err error
+
+	var data InstallInsightsLayoutModuleResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func InstallNotebookModule(
+	ctx context.Context,
+	client graphql.Client,
+	input InstallNotebookModuleInput,
+) (*InstallNotebookModuleResponse, error) {
+	req := &graphql.Request{
+		OpName: "InstallNotebookModule",
+		Query: `
+mutation InstallNotebookModule ($input: InstallNotebookModuleInput!) {
+	installNotebookModule(input: $input) {
+		moduleId
+	}
+}
+`,
+		Variables: &__InstallNotebookModuleInput{
+			Input: input,
+		},
+	}
+	var err error
+
+	var data InstallNotebookModuleResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func InstallPatientLayoutModule(
+	ctx context.Context,
+	client graphql.Client,
+	input InstallPatientLayoutModuleInput,
+) (*InstallPatientLayoutModuleResponse, error) {
+	req := &graphql.Request{
+		OpName: "InstallPatientLayoutModule",
+		Query: `
+mutation InstallPatientLayoutModule ($input: InstallPatientLayoutModuleInput!) {
+	installPatientLayoutModule(input: $input) {
+		moduleId
+	}
+}
+`,
+		Variables: &__InstallPatientLayoutModuleInput{
+			Input: input,
+		},
+	}
+	var err error
+
+	var data InstallPatientLayoutModuleResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func InstallProcessOntologyModule(
+	ctx context.Context,
+	client graphql.Client,
+	input InstallProcessOntologyModuleInput,
+) (*InstallProcessOntologyModuleResponse, error) {
+	req := &graphql.Request{
+		OpName: "InstallProcessOntologyModule",
+		Query: `
+mutation InstallProcessOntologyModule ($input: InstallProcessOntologyModuleInput!) {
+	installProcessOntologyModule(input: $input) {
+		id
+	}
+}
+`,
+		Variables: &__InstallProcessOntologyModuleInput{
+			Input: input,
+		},
+	}
+	var err error
+
+	var data InstallProcessOntologyModuleResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func InstallProgramEnrollmentModule(
+	ctx context.Context,
+	client graphql.Client,
+	input InstallProgramEnrollmentModuleInput,
+) (*InstallProgramEnrollmentModuleResponse, error) {
+	req := &graphql.Request{
+		OpName: "InstallProgramEnrollmentModule",
+		Query: `
+mutation InstallProgramEnrollmentModule ($input: InstallProgramEnrollmentModuleInput!) {
+	installProgramEnrollmentModule(input: $input) {
+		moduleId
+	}
+}
+`,
+		Variables: &__InstallProgramEnrollmentModuleInput{
+			Input: input,
+		},
+	}
+	var err error
+
+	var data InstallProgramEnrollmentModuleResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func InstallProgramTemplateModule(
+	ctx context.Context,
+	client graphql.Client,
+	input InstallProgramTemplateModuleInput,
+) (*InstallProgramTemplateModuleResponse, error) {
+	req := &graphql.Request{
+		OpName: "InstallProgramTemplateModule",
+		Query: `
+mutation InstallProgramTemplateModule ($input: InstallProgramTemplateModuleInput!) {
+	installProgramTemplateModule(input: $input) {
+		moduleId
+	}
+}
+`,
+		Variables: &__InstallProgramTemplateModuleInput{
+			Input: input,
+		},
+	}
+	var err error
+
+	var data InstallProgramTemplateModuleResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func InstallPublicAppTileModule(
+	ctx context.Context,
+	client graphql.Client,
+	input InstallPublicAppTileModuleInput,
+) (*InstallPublicAppTileModuleResponse, error) {
+	req := &graphql.Request{
+		OpName: "InstallPublicAppTileModule",
+		Query: `
+mutation InstallPublicAppTileModule ($input: InstallPublicAppTileModuleInput!) {
+	installPublicAppTileModule(input: $input) {
+		appTileId
+		moduleId
+	}
+}
+`,
+		Variables: &__InstallPublicAppTileModuleInput{
+			Input: input,
+		},
+	}
+	var err error
+
+	var data InstallPublicAppTileModuleResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
 }
 
-// GetId returns StartImageUploadStartUploadStartUploadResponse.Id, and is useful for accessing the field via an interface.
-func (v *StartImageUploadStartUploadStartUploadResponse) GetId() string { return v.Id }
+func InstallReportExtractorModule(
+	ctx context.Context,
+	client graphql.Client,
+	input InstallReportExtractorModuleInput,
+) (*InstallReportExtractorModuleResponse, error) {
+	req := &graphql.Request{
+		OpName: "InstallReportExtractorModule",
+		Query: `
+mutation InstallReportExtractorModule ($input: InstallReportExtractorModuleInput!) {
+	installReportExtractorModule(input: $input) {
+		moduleId
+	}
+}
+`,
+		Variables: &__InstallReportExtractorModuleInput{
+			Input: input,
+		},
+	}
+	var err error
 
-// GetUrl returns StartImageUploadStartUploadStartUploadResponse.Url, and is useful for accessing the field via an interface.
-func (v *StartImageUploadStartUploadStartUploadResponse) GetUrl() string { return v.Url }
+	var data InstallReportExtractorModuleResponse
+	resp := &graphql.Response{Data: &data}
 
-// GetFields returns StartImageUploadStartUploadStartUploadResponse.Fields, and is useful for accessing the field via an interface.
-func (v *StartImageUploadStartUploadStartUploadResponse) GetFields() map[string]string {
-	return v.Fields
-}
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
 
-type StartUploadInput struct {
-	FileName string `json:"fileName"`
+	return &data, err
 }
 
-// GetFileName returns StartUploadInput.FileName, and is useful for accessing the field via an interface.
-func (v *StartUploadInput) GetFileName() string { return v.FileName }
+func InstallSearchLayoutModule(
+	ctx context.Context,
+	client graphql.Client,
+	input InstallSearchLayoutModuleInput,
+) (*InstallSearchLayoutModuleResponse, error) {
+	req := &graphql.Request{
+		OpName: "InstallSearchLayoutModule",
+		Query: `
+mutation InstallSearchLayoutModule ($input: InstallSearchLayoutModuleInput!) {
+	installSearchLayoutModule(input: $input) {
+		moduleId
+	}
+}
+`,
+		Variables: &__InstallSearchLayoutModuleInput{
+			Input: input,
+		},
+	}
+	var err error
 
-type UploadType string
+	var data InstallSearchLayoutModuleResponse
+	resp := &graphql.Response{Data: &data}
 
-const (
-	UploadTypeIcon         UploadType = "ICON"
-	UploadTypePreviewImage UploadType = "PREVIEW_IMAGE"
-)
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
 
-// __CreateDraftModuleInput is used internally by genqlient
-type __CreateDraftModuleInput struct {
-	Input CreateDraftModuleInput `json:"input"`
+	return &data, err
 }
 
-// GetInput returns __CreateDraftModuleInput.Input, and is useful for accessing the field via an interface.
-func (v *__CreateDraftModuleInput) GetInput() CreateDraftModuleInput { return v.Input }
-
-// __DeleteModuleInput is used internally by genqlient
-type __DeleteModuleInput struct {
-	Input DeleteModuleInput `json:"input"`
+func InstallSurveyModule(
+	ctx context.Context,
+	client graphql.Client,
+	input InstallSurveyModuleInput,
+) (*InstallSurveyModuleResponse, error) {
+	req := &graphql.Request{
+		OpName: "InstallSurveyModule",
+		Query: `
+mutation InstallSurveyModule ($input: InstallSurveyModuleInput!) {
+	installSurveyModule(input: $input) {
+		moduleId
+	}
 }
+`,
+		Variables: &__InstallSurveyModuleInput{
+			Input: input,
+		},
+	}
+	var err error
 
-// GetInput returns __DeleteModuleInput.Input, and is useful for accessing the field via an interface.
-func (v *__DeleteModuleInput) GetInput() DeleteModuleInput { return v.Input }
+	var data InstallSurveyModuleResponse
+	resp := &graphql.Response{Data: &data}
 
-// __FinalizeImageUploadInput is used internally by genqlient
-type __FinalizeImageUploadInput struct {
-	Input FinalizeUploadInput `json:"input"`
-}
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
 
-// GetInput returns __FinalizeImageUploadInput.Input, and is useful for accessing the field via an interface.
-func (v *__FinalizeImageUploadInput) GetInput() FinalizeUploadInput { return v.Input }
+	return &data, err
+}
 
-// __GetPublishedModuleInput is used internally by genqlient
-type __GetPublishedModuleInput struct {
-	Id      string `json:"id"`
-	Version string `json:"version"`
+func InstallWellnessOfferingModule(
+	ctx context.Context,
+	client graphql.Client,
+	input InstallWellnessOfferingModuleInput,
+) (*InstallWellnessOfferingModuleResponse, error) {
+	req := &graphql.Request{
+		OpName: "InstallWellnessOfferingModule",
+		Query: `
+mutation InstallWellnessOfferingModule ($input: InstallWellnessOfferingModuleInput!) {
+	installWellnessOfferingModule(input: $input) {
+		id
+		version
+	}
 }
+`,
+		Variables: &__InstallWellnessOfferingModuleInput{
+			Input: input,
+		},
+	}
+	var err error
 
-// GetId returns __GetPublishedModuleInput.Id, and is useful for accessing the field via an interface.
-func (v *__GetPublishedModuleInput) GetId() string { return v.Id }
+	var data InstallWellnessOfferingModuleResponse
+	resp := &graphql.Response{Data: &data}
 
-// GetVersion returns __GetPublishedModuleInput.Version, and is useful for accessing the field via an interface.
-func (v *__GetPublishedModuleInput) GetVersion() string { return v.Version }
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
 
-// __PublishModuleInput is used internally by genqlient
-type __PublishModuleInput struct {
-	Input PublishDraftModuleInputV2 `json:"input"`
+	return &data, err
 }
 
-// GetInput returns __PublishModuleInput.Input, and is useful for accessing the field via an interface.
-func (v *__PublishModuleInput) GetInput() PublishDraftModuleInputV2 { return v.Input }
-
-// __SetAppTileInput is used internally by genqlient
-type __SetAppTileInput struct {
-	Input SetPublicAppTileDraftModuleSourceInput `json:"input"`
+func InstallWorkflowModule(
+	ctx context.Context,
+	client graphql.Client,
+	input InstallWorkflowModuleInput,
+) (*InstallWorkflowModuleResponse, error) {
+	req := &graphql.Request{
+		OpName: "InstallWorkflowModule",
+		Query: `
+mutation InstallWorkflowModule ($input: InstallWorkflowModuleInput!) {
+	installWorkflowModule(input: $input) {
+		moduleId
+	}
 }
+`,
+		Variables: &__InstallWorkflowModuleInput{
+			Input: input,
+		},
+	}
+	var err error
 
-// GetInput returns __SetAppTileInput.Input, and is useful for accessing the field via an interface.
-func (v *__SetAppTileInput) GetInput() SetPublicAppTileDraftModuleSourceInput { return v.Input }
+	var data InstallWorkflowModuleResponse
+	resp := &graphql.Response{Data: &data}
 
-// __StartImageUploadInput is used internally by genqlient
-type __StartImageUploadInput struct {
-	Input StartUploadInput `json:"input"`
-}
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
 
-// GetInput returns __StartImageUploadInput.Input, and is useful for accessing the field via an interface.
-func (v *__StartImageUploadInput) GetInput() StartUploadInput { return v.Input }
+	return &data, err
+}
 
-func CreateDraftModule(
+func PublishModule(
 	ctx context.Context,
 	client graphql.Client,
-	input CreateDraftModuleInput,
-) (*CreateDraftModuleResponse, error) {
+	input PublishDraftModuleInputV2,
+) (*PublishModuleResponse, error) {
 	req := &graphql.Request{
-		OpName: "CreateDraftModule",
+		OpName: "PublishModule",
 		Query: `
-mutation CreateDraftModule ($input: CreateDraftModuleInput!) {
-	createDraftModule(input: $input) {
+mutation PublishModule ($input: PublishDraftModuleInputV2!) {
+	publishDraftModuleV2(input: $input) {
 		id
+		version {
+			version
+		}
 	}
 }
 `,
-		Variables: &__CreateDraftModuleInput{
+		Variables: &__PublishModuleInput{
 			Input: input,
 		},
 	}
 	var err error
 
-	var data CreateDraftModuleResponse
+	var data PublishModuleResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -1067,27 +9090,31 @@ mutation CreateDraftModule ($input: CreateDraftModuleInput!) {
 	return &data, err
 }
 
-func DeleteModule(
+func PublishModuleV3(
 	ctx context.Context,
 	client graphql.Client,
-	input DeleteModuleInput,
-) (*DeleteModuleResponse, error) {
+	input PublishDraftModuleInputV3,
+) (*PublishModuleV3Response, error) {
 	req := &graphql.Request{
-		OpName: "DeleteModule",
+		OpName: "PublishModuleV3",
 		Query: `
-mutation DeleteModule ($input: DeleteModuleInput!) {
-	deleteModule(input: $input) {
+mutation PublishModuleV3 ($input: PublishDraftModuleInputV3!) {
+	publishDraftModuleV3(input: $input) {
 		id
+		publishReviewId
+		version {
+			version
+		}
 	}
 }
 `,
-		Variables: &__DeleteModuleInput{
+		Variables: &__PublishModuleV3Input{
 			Input: input,
 		},
 	}
 	var err error
 
-	var data DeleteModuleResponse
+	var data PublishModuleV3Response
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -1099,27 +9126,27 @@ mutation DeleteModule ($input: DeleteModuleInput!) {
 	return &data, err
 }
 
-func FinalizeImageUpload(
+func RemoveDraftModuleIcon(
 	ctx context.Context,
 	client graphql.Client,
-	input FinalizeUploadInput,
-) (*FinalizeImageUploadResponse, error) {
+	input RemoveDraftModuleIconV2Input,
+) (*RemoveDraftModuleIconResponse, error) {
 	req := &graphql.Request{
-		OpName: "FinalizeImageUpload",
+		OpName: "RemoveDraftModuleIcon",
 		Query: `
-mutation FinalizeImageUpload ($input: FinalizeUploadInput!) {
-	finalizeUpload(input: $input) {
+mutation RemoveDraftModuleIcon ($input: RemoveDraftModuleIconV2Input!) {
+	removeDraftModuleIconV2(input: $input) {
 		moduleId
 	}
 }
 `,
-		Variables: &__FinalizeImageUploadInput{
+		Variables: &__RemoveDraftModuleIconInput{
 			Input: input,
 		},
 	}
 	var err error
 
-	var data FinalizeImageUploadResponse
+	var data RemoveDraftModuleIconResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -1131,45 +9158,27 @@ mutation FinalizeImageUpload ($input: FinalizeUploadInput!) {
 	return &data, err
 }
 
-func GetPublishedModule(
+func RemoveDraftModulePreviewImage(
 	ctx context.Context,
 	client graphql.Client,
-	id string,
-	version string,
-) (*GetPublishedModuleResponse, error) {
+	input RemoveDraftModulePreviewImagesV2Input,
+) (*RemoveDraftModulePreviewImageResponse, error) {
 	req := &graphql.Request{
-		OpName: "GetPublishedModule",
+		OpName: "RemoveDraftModulePreviewImage",
 		Query: `
-query GetPublishedModule ($id: ID!, $version: String) {
-	myModule(moduleId: $id, version: $version) {
-		... AppTileModule
-	}
-}
-fragment AppTileModule on MarketplaceModule {
-	title
-	description
-	version
-	source {
-		__typename
-		... on AppTile {
-			id
-		}
-	}
-	iconV2 {
-		url
-		fileName
-		fileExtension
+mutation RemoveDraftModulePreviewImage ($input: RemoveDraftModulePreviewImagesV2Input!) {
+	removeDraftModulePreviewImagesV2(input: $input) {
+		moduleId
 	}
 }
 `,
-		Variables: &__GetPublishedModuleInput{
-			Id:      id,
-			Version: version,
+		Variables: &__RemoveDraftModulePreviewImageInput{
+			Input: input,
 		},
 	}
 	var err error
 
-	var data GetPublishedModuleResponse
+	var data RemoveDraftModulePreviewImageResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -1181,30 +9190,27 @@ fragment AppTileModule on MarketplaceModule {
 	return &data, err
 }
 
-func PublishModule(
+func RemoveMarketplaceReviewReply(
 	ctx context.Context,
 	client graphql.Client,
-	input PublishDraftModuleInputV2,
-) (*PublishModuleResponse, error) {
+	input RemoveMarketplaceReviewReplyInput_v2,
+) (*RemoveMarketplaceReviewReplyResponse, error) {
 	req := &graphql.Request{
-		OpName: "PublishModule",
+		OpName: "RemoveMarketplaceReviewReply",
 		Query: `
-mutation PublishModule ($input: PublishDraftModuleInputV2!) {
-	publishDraftModuleV2(input: $input) {
+mutation RemoveMarketplaceReviewReply ($input: RemoveMarketplaceReviewReplyInput_v2!) {
+	removeMarketplaceReviewReply_v2(input: $input) {
 		id
-		version {
-			version
-		}
 	}
 }
 `,
-		Variables: &__PublishModuleInput{
+		Variables: &__RemoveMarketplaceReviewReplyInput{
 			Input: input,
 		},
 	}
 	var err error
 
-	var data PublishModuleResponse
+	var data RemoveMarketplaceReviewReplyResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -1281,3 +9287,35 @@ mutation StartImageUpload ($input: StartUploadInput!) {
 
 	return &data, err
 }
+
+func UpdateMarketplaceReviewReply(
+	ctx context.Context,
+	client graphql.Client,
+	input UpdateMarketplaceReviewReplyInput_v2,
+) (*UpdateMarketplaceReviewReplyResponse, error) {
+	req := &graphql.Request{
+		OpName: "UpdateMarketplaceReviewReply",
+		Query: `
+mutation UpdateMarketplaceReviewReply ($input: UpdateMarketplaceReviewReplyInput_v2!) {
+	updateMarketplaceReviewReply_v2(input: $input) {
+		id
+	}
+}
+`,
+		Variables: &__UpdateMarketplaceReviewReplyInput{
+			Input: input,
+		},
+	}
+	var err error
+
+	var data UpdateMarketplaceReviewReplyResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
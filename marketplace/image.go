@@ -0,0 +1,340 @@
+package marketplace
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	_ "image/gif"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"strconv"
+	"strings"
+)
+
+const (
+	IMAGE_FORMAT_PNG  = "png"
+	IMAGE_FORMAT_JPEG = "jpeg"
+	IMAGE_FORMAT_GIF  = "gif"
+	IMAGE_FORMAT_WEBP = "webp"
+	IMAGE_FORMAT_SVG  = "svg"
+)
+
+// imageLimits are the store's requirements for an uploaded image, zero values are not enforced
+type imageLimits struct {
+	MaxBytes  int
+	MinWidth  int
+	MinHeight int
+	MaxWidth  int
+	MaxHeight int
+	Square    bool
+}
+
+// imageOptions control how an image is normalized before upload
+type imageOptions struct {
+	Limits imageLimits
+	// Scale raster images into the limits instead of rejecting them
+	Resize bool
+	// Re-encode raster images as PNG
+	ConvertToPNG bool
+}
+
+type imageInfo struct {
+	Format string
+	// Zero when a vector image doesn't declare its size
+	Width  int
+	Height int
+}
+
+type preparedImage struct {
+	FileName    string
+	ContentType string
+	Content     []byte
+}
+
+func (image *preparedImage) Reader() io.ReadSeeker {
+	return bytes.NewReader(image.Content)
+}
+
+func webpConfig(data []byte) (imageInfo, error) {
+	info := imageInfo{Format: IMAGE_FORMAT_WEBP}
+	if len(data) < 30 {
+		return info, errors.New("truncated webp image")
+	}
+
+	switch string(data[12:16]) {
+	case "VP8 ":
+		if !bytes.Equal(data[23:26], []byte{0x9d, 0x01, 0x2a}) {
+			return info, errors.New("invalid webp VP8 frame")
+		}
+		info.Width = int(binary.LittleEndian.Uint16(data[26:28]) & 0x3fff)
+		info.Height = int(binary.LittleEndian.Uint16(data[28:30]) & 0x3fff)
+	case "VP8L":
+		if data[20] != 0x2f {
+			return info, errors.New("invalid webp VP8L signature")
+		}
+		bits := binary.LittleEndian.Uint32(data[21:25])
+		info.Width = int(bits&0x3fff) + 1
+		info.Height = int((bits>>14)&0x3fff) + 1
+	case "VP8X":
+		info.Width = int(uint32(data[24])|uint32(data[25])<<8|uint32(data[26])<<16) + 1
+		info.Height = int(uint32(data[27])|uint32(data[28])<<8|uint32(data[29])<<16) + 1
+	default:
+		return info, fmt.Errorf("unknown webp chunk %q", data[12:16])
+	}
+	return info, nil
+}
+
+// svgLength parses an absolute SVG length, percentages and relative units are unknown (0)
+func svgLength(value string) int {
+	value = strings.TrimSuffix(strings.TrimSpace(value), "px")
+	length, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0
+	}
+	return int(length)
+}
+
+func svgConfig(data []byte) (imageInfo, error) {
+	info := imageInfo{Format: IMAGE_FORMAT_SVG}
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			return info, errors.New("no svg element found")
+		}
+		if err != nil {
+			return info, fmt.Errorf("invalid svg: %w", err)
+		}
+
+		element, ok := token.(xml.StartElement)
+		if !ok {
+			continue
+		}
+		if element.Name.Local != "svg" {
+			return info, fmt.Errorf("expected an svg root element, got %s", element.Name.Local)
+		}
+
+		viewBox := ""
+		for _, attr := range element.Attr {
+			switch attr.Name.Local {
+			case "width":
+				info.Width = svgLength(attr.Value)
+			case "height":
+				info.Height = svgLength(attr.Value)
+			case "viewBox":
+				viewBox = attr.Value
+			}
+		}
+		if fields := strings.Fields(strings.ReplaceAll(viewBox, ",", " ")); len(fields) == 4 && (info.Width == 0 || info.Height == 0) {
+			info.Width = svgLength(fields[2])
+			info.Height = svgLength(fields[3])
+		}
+		return info, nil
+	}
+}
+
+// inspectImage detects the format and dimensions of PNG, JPEG, GIF, WebP and SVG images
+func inspectImage(data []byte) (imageInfo, error) {
+	if len(data) >= 12 && string(data[0:4]) == "RIFF" && string(data[8:12]) == "WEBP" {
+		return webpConfig(data)
+	}
+
+	config, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err == nil {
+		return imageInfo{Format: format, Width: config.Width, Height: config.Height}, nil
+	}
+
+	head := data
+	if len(head) > 1024 {
+		head = head[:1024]
+	}
+	if strings.Contains(string(head), "<svg") {
+		return svgConfig(data)
+	}
+	return imageInfo{}, errors.New("unsupported image format, use PNG, JPEG, GIF, WebP or SVG")
+}
+
+// check returns every limit the image breaks
+func (limits imageLimits) check(info imageInfo, size int) []string {
+	problems := []string{}
+	if limits.MaxBytes > 0 && size > limits.MaxBytes {
+		problems = append(problems, fmt.Sprintf("is %d bytes, the limit is %d", size, limits.MaxBytes))
+	}
+	if info.Width == 0 || info.Height == 0 {
+		return problems
+	}
+	if info.Width < limits.MinWidth || info.Height < limits.MinHeight {
+		problems = append(problems, fmt.Sprintf("is %dx%d, it must be at least %dx%d", info.Width, info.Height, limits.MinWidth, limits.MinHeight))
+	}
+	if (limits.MaxWidth > 0 && info.Width > limits.MaxWidth) || (limits.MaxHeight > 0 && info.Height > limits.MaxHeight) {
+		problems = append(problems, fmt.Sprintf("is %dx%d, it must be at most %dx%d", info.Width, info.Height, limits.MaxWidth, limits.MaxHeight))
+	}
+	if limits.Square && info.Width != info.Height {
+		problems = append(problems, fmt.Sprintf("is %dx%d, it must be square", info.Width, info.Height))
+	}
+	return problems
+}
+
+// fitDimensions scales width and height, keeping the aspect ratio, until they are within the limits
+func (limits imageLimits) fitDimensions(width, height int) (int, int) {
+	scale := 1.0
+	if limits.MaxWidth > 0 && width > limits.MaxWidth {
+		scale = float64(limits.MaxWidth) / float64(width)
+	}
+	if limits.MaxHeight > 0 && float64(height)*scale > float64(limits.MaxHeight) {
+		scale = float64(limits.MaxHeight) / float64(height)
+	}
+	if scale == 1.0 {
+		if width < limits.MinWidth {
+			scale = float64(limits.MinWidth) / float64(width)
+		}
+		if float64(height)*scale < float64(limits.MinHeight) {
+			scale = float64(limits.MinHeight) / float64(height)
+		}
+	}
+
+	scaled := func(length int) int {
+		result := int(float64(length)*scale + 0.5)
+		if result < 1 {
+			return 1
+		}
+		return result
+	}
+	return scaled(width), scaled(height)
+}
+
+// resizeImage scales src to width by height with bilinear interpolation
+func resizeImage(src image.Image, width, height int) *image.NRGBA {
+	bounds := src.Bounds()
+	source := image.NewNRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	draw.Draw(source, source.Bounds(), src, bounds.Min, draw.Src)
+
+	dst := image.NewNRGBA(image.Rect(0, 0, width, height))
+	xRatio := float64(bounds.Dx()) / float64(width)
+	yRatio := float64(bounds.Dy()) / float64(height)
+	for y := 0; y < height; y++ {
+		sy := (float64(y)+0.5)*yRatio - 0.5
+		y0 := clamp(int(sy), bounds.Dy()-1)
+		y1 := clamp(y0+1, bounds.Dy()-1)
+		fy := sy - float64(y0)
+		if fy < 0 {
+			fy = 0
+		}
+		for x := 0; x < width; x++ {
+			sx := (float64(x)+0.5)*xRatio - 0.5
+			x0 := clamp(int(sx), bounds.Dx()-1)
+			x1 := clamp(x0+1, bounds.Dx()-1)
+			fx := sx - float64(x0)
+			if fx < 0 {
+				fx = 0
+			}
+
+			c00 := source.NRGBAAt(x0, y0)
+			c10 := source.NRGBAAt(x1, y0)
+			c01 := source.NRGBAAt(x0, y1)
+			c11 := source.NRGBAAt(x1, y1)
+			mix := func(a, b, c, d uint8) uint8 {
+				top := float64(a)*(1-fx) + float64(b)*fx
+				bottom := float64(c)*(1-fx) + float64(d)*fx
+				return uint8(top*(1-fy) + bottom*fy + 0.5)
+			}
+			dst.SetNRGBA(x, y, color.NRGBA{
+				R: mix(c00.R, c10.R, c01.R, c11.R),
+				G: mix(c00.G, c10.G, c01.G, c11.G),
+				B: mix(c00.B, c10.B, c01.B, c11.B),
+				A: mix(c00.A, c10.A, c01.A, c11.A),
+			})
+		}
+	}
+	return dst
+}
+
+func clamp(value, max int) int {
+	if value < 0 {
+		return 0
+	}
+	if value > max {
+		return max
+	}
+	return value
+}
+
+// normalizeImage resizes and re-encodes a raster image as requested by the options
+func normalizeImage(data []byte, info imageInfo, options imageOptions) ([]byte, imageInfo, error) {
+	// Resizing keeps the aspect ratio, so it can't fix an image that isn't square
+	sizeLimits := options.Limits
+	sizeLimits.Square = false
+	resize := options.Resize && len(sizeLimits.check(info, 0)) > 0
+	convert := options.ConvertToPNG && info.Format != IMAGE_FORMAT_PNG
+	if !resize && !convert {
+		return data, info, nil
+	}
+	if info.Format == IMAGE_FORMAT_WEBP || info.Format == IMAGE_FORMAT_SVG {
+		return nil, info, fmt.Errorf("%s images can't be resized or converted, export a PNG instead", info.Format)
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, info, err
+	}
+	if resize {
+		width, height := options.Limits.fitDimensions(info.Width, info.Height)
+		img = resizeImage(img, width, height)
+		info.Width, info.Height = width, height
+	}
+
+	encoded := &bytes.Buffer{}
+	if info.Format == IMAGE_FORMAT_JPEG && !convert {
+		err = jpeg.Encode(encoded, img, &jpeg.Options{Quality: 90})
+	} else {
+		err = png.Encode(encoded, img)
+		info.Format = IMAGE_FORMAT_PNG
+	}
+	if err != nil {
+		return nil, info, err
+	}
+	return encoded.Bytes(), info, nil
+}
+
+// prepareImage reads, normalizes and validates an image so that bad images fail during plan
+func prepareImage(source imageSource, options imageOptions) (*preparedImage, error) {
+	reader, err := source.Open()
+	if err != nil {
+		return nil, fmt.Errorf("image %s: %w", source, err)
+	}
+	defer reader.Close()
+
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, fmt.Errorf("image %s: %w", source, err)
+	}
+
+	info, err := inspectImage(data)
+	if err != nil {
+		if reader.ContentType != "" && !strings.HasPrefix(reader.ContentType, "image/") {
+			return nil, fmt.Errorf("image %s has content type %s: %w", source, reader.ContentType, err)
+		}
+		return nil, fmt.Errorf("image %s: %w", source, err)
+	}
+
+	data, info, err = normalizeImage(data, info, options)
+	if err != nil {
+		return nil, fmt.Errorf("image %s: %w", source, err)
+	}
+
+	if problems := options.Limits.check(info, len(data)); len(problems) > 0 {
+		return nil, fmt.Errorf("image %s %s", source, strings.Join(problems, ", "))
+	}
+
+	return &preparedImage{
+		FileName:    imageFileName(reader.FileName, info.Format),
+		ContentType: imageContentTypes[info.Format],
+		Content:     data,
+	}, nil
+}
@@ -0,0 +1,117 @@
+package marketplace
+
+import (
+	"encoding/base64"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"strings"
+	"time"
+)
+
+// imageReader is an opened image, FileName and ContentType are empty when the source doesn't know them
+type imageReader struct {
+	io.ReadCloser
+	FileName    string
+	ContentType string
+}
+
+// imageSource is somewhere an image can be read from, such as a file, an inline value or a URL
+type imageSource interface {
+	Open() (*imageReader, error)
+	String() string
+}
+
+type fileImageSource struct {
+	Path string
+}
+
+func (source fileImageSource) Open() (*imageReader, error) {
+	file, err := os.Open(source.Path)
+	if err != nil {
+		return nil, err
+	}
+	return &imageReader{ReadCloser: file, FileName: path.Base(source.Path)}, nil
+}
+
+func (source fileImageSource) String() string {
+	return source.Path
+}
+
+type base64ImageSource struct {
+	Data string
+}
+
+func (source base64ImageSource) Open() (*imageReader, error) {
+	data := strings.Join(strings.Fields(source.Data), "")
+	reader := base64.NewDecoder(base64.StdEncoding, strings.NewReader(data))
+	return &imageReader{ReadCloser: io.NopCloser(reader)}, nil
+}
+
+func (source base64ImageSource) String() string {
+	return "image_base64"
+}
+
+// imageHttpClient downloads images from URLs, the timeout covers reading the whole body
+var imageHttpClient = &http.Client{Timeout: time.Minute}
+
+type urlImageSource struct {
+	Url string
+}
+
+func (source urlImageSource) Open() (*imageReader, error) {
+	resp, err := imageHttpClient.Get(source.Url)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		resp.Body.Close()
+		return nil, fmt.Errorf("GET %s returned %s", source.Url, resp.Status)
+	}
+
+	fileName := ""
+	if parsed, err := url.Parse(source.Url); err == nil && path.Ext(parsed.Path) != "" {
+		fileName = path.Base(parsed.Path)
+	}
+	if _, params, err := mime.ParseMediaType(resp.Header.Get("Content-Disposition")); err == nil && params["filename"] != "" {
+		fileName = path.Base(params["filename"])
+	}
+	return &imageReader{ReadCloser: resp.Body, FileName: fileName, ContentType: resp.Header.Get("Content-Type")}, nil
+}
+
+func (source urlImageSource) String() string {
+	return source.Url
+}
+
+var imageContentTypes = map[string]string{
+	IMAGE_FORMAT_PNG:  "image/png",
+	IMAGE_FORMAT_JPEG: "image/jpeg",
+	IMAGE_FORMAT_GIF:  "image/gif",
+	IMAGE_FORMAT_WEBP: "image/webp",
+	IMAGE_FORMAT_SVG:  "image/svg+xml",
+}
+
+var imageExtensions = map[string]string{
+	IMAGE_FORMAT_PNG:  ".png",
+	IMAGE_FORMAT_JPEG: ".jpg",
+	IMAGE_FORMAT_GIF:  ".gif",
+	IMAGE_FORMAT_WEBP: ".webp",
+	IMAGE_FORMAT_SVG:  ".svg",
+}
+
+// imageFileName names the uploaded file, taking the extension from the detected content when the
+// source has no file name or the image was converted to another format
+func imageFileName(name string, format string) string {
+	extension := imageExtensions[format]
+	if name == "" {
+		return "icon" + extension
+	}
+	if current := strings.ToLower(path.Ext(name)); current == extension || (format == IMAGE_FORMAT_JPEG && current == ".jpeg") {
+		return name
+	}
+	return strings.TrimSuffix(name, path.Ext(name)) + extension
+}
@@ -0,0 +1,87 @@
+package marketplace
+
+import (
+	"bytes"
+	"encoding/base64"
+	"image"
+	"image/png"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func encodeTestPNG(t *testing.T, width, height int) []byte {
+	encoded := &bytes.Buffer{}
+	if err := png.Encode(encoded, image.NewNRGBA(image.Rect(0, 0, width, height))); err != nil {
+		t.Fatal(err)
+	}
+	return encoded.Bytes()
+}
+
+func TestInspectImage(t *testing.T) {
+	webp := append([]byte("RIFF\x00\x00\x00\x00WEBPVP8X\x0a\x00\x00\x00\x00\x00\x00\x00"), 0xef, 0x00, 0x00, 0x77, 0x00, 0x00)
+
+	cases := []struct {
+		name string
+		data []byte
+		info imageInfo
+	}{
+		{"png", encodeTestPNG(t, 240, 120), imageInfo{Format: IMAGE_FORMAT_PNG, Width: 240, Height: 120}},
+		{"webp", webp, imageInfo{Format: IMAGE_FORMAT_WEBP, Width: 240, Height: 120}},
+		{"svg", []byte(`<?xml version="1.0"?><svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 48 32"></svg>`), imageInfo{Format: IMAGE_FORMAT_SVG, Width: 48, Height: 32}},
+		{"svg size", []byte(`<svg width="100px" height="50" viewBox="0 0 10 5"/>`), imageInfo{Format: IMAGE_FORMAT_SVG, Width: 100, Height: 50}},
+	}
+
+	for _, c := range cases {
+		info, err := inspectImage(c.data)
+		if err != nil {
+			t.Errorf("%s: %s", c.name, err)
+			continue
+		}
+		if info != c.info {
+			t.Errorf("%s: got %+v, expected %+v", c.name, info, c.info)
+		}
+	}
+
+	if _, err := inspectImage([]byte("not an image")); err == nil {
+		t.Error("expected an error for an unsupported format")
+	}
+}
+
+func TestPrepareImage(t *testing.T) {
+	imagePath := filepath.Join(t.TempDir(), "icon.png")
+	if err := os.WriteFile(imagePath, encodeTestPNG(t, 400, 200), 0644); err != nil {
+		t.Fatal(err)
+	}
+	limits := imageLimits{MinWidth: 64, MinHeight: 64, MaxWidth: 200, MaxHeight: 200}
+
+	if _, err := prepareImage(fileImageSource{Path: imagePath}, imageOptions{Limits: limits}); err == nil {
+		t.Error("expected an image larger than the limits to be rejected")
+	}
+
+	prepared, err := prepareImage(fileImageSource{Path: imagePath}, imageOptions{Limits: limits, Resize: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	info, err := inspectImage(prepared.Content)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Width != 200 || info.Height != 100 {
+		t.Errorf("expected the image to be resized to 200x100, got %dx%d", info.Width, info.Height)
+	}
+
+	inline := base64ImageSource{Data: base64.StdEncoding.EncodeToString(encodeTestPNG(t, 100, 100))}
+	prepared, err = prepareImage(inline, imageOptions{Limits: limits})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if prepared.FileName != "icon.png" || prepared.ContentType != "image/png" {
+		t.Errorf("expected icon.png with image/png, got %s with %s", prepared.FileName, prepared.ContentType)
+	}
+
+	limits.Square = true
+	if _, err := prepareImage(fileImageSource{Path: imagePath}, imageOptions{Limits: limits, Resize: true}); err == nil {
+		t.Error("expected an image that isn't square to be rejected")
+	}
+}
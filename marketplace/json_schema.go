@@ -0,0 +1,163 @@
+package marketplace
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// normalizeJSON re-encodes a JSON document with sorted keys and no extra whitespace
+func normalizeJSON(document string) (string, error) {
+	var value interface{}
+	if err := json.Unmarshal([]byte(document), &value); err != nil {
+		return "", err
+	}
+	normalized, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+	return string(normalized), nil
+}
+
+func jsonPath(path string, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+func jsonTypeOf(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case float64:
+		if v == float64(int64(v)) {
+			return "integer"
+		}
+		return "number"
+	case string:
+		return "string"
+	case []interface{}:
+		return "array"
+	case map[string]interface{}:
+		return "object"
+	}
+	return "unknown"
+}
+
+func jsonTypeMatches(expected string, value interface{}) bool {
+	actual := jsonTypeOf(value)
+	return expected == actual || (expected == "number" && actual == "integer")
+}
+
+// validateJSONSchema checks a decoded JSON value against the subset of JSON Schema
+// used by offering configuration schemas: type, enum, const, required, properties,
+// additionalProperties, items, minimum, maximum, minLength, maxLength and pattern.
+// Every violation is returned so they can be reported together.
+func validateJSONSchema(schema map[string]interface{}, value interface{}, path string) []string {
+	location := "configuration"
+	if strings.HasPrefix(path, "[") {
+		location += path
+	} else if path != "" {
+		location += "." + path
+	}
+	problems := []string{}
+
+	switch expected := schema["type"].(type) {
+	case string:
+		if !jsonTypeMatches(expected, value) {
+			return append(problems, fmt.Sprintf("%s: expected %s, got %s", location, expected, jsonTypeOf(value)))
+		}
+	case []interface{}:
+		matched := false
+		names := []string{}
+		for _, option := range expected {
+			name, _ := option.(string)
+			names = append(names, name)
+			matched = matched || jsonTypeMatches(name, value)
+		}
+		if !matched {
+			return append(problems, fmt.Sprintf("%s: expected one of %s, got %s", location, strings.Join(names, ", "), jsonTypeOf(value)))
+		}
+	}
+
+	if enum, ok := schema["enum"].([]interface{}); ok {
+		found := false
+		for _, option := range enum {
+			found = found || reflect.DeepEqual(option, value)
+		}
+		if !found {
+			problems = append(problems, fmt.Sprintf("%s: value is not one of the allowed values", location))
+		}
+	}
+
+	if constant, ok := schema["const"]; ok && !reflect.DeepEqual(constant, value) {
+		problems = append(problems, fmt.Sprintf("%s: value must be %v", location, constant))
+	}
+
+	switch v := value.(type) {
+	case map[string]interface{}:
+		if required, ok := schema["required"].([]interface{}); ok {
+			for _, key := range required {
+				name, _ := key.(string)
+				if _, exists := v[name]; !exists {
+					problems = append(problems, fmt.Sprintf("%s: missing required property %s", location, name))
+				}
+			}
+		}
+
+		properties, _ := schema["properties"].(map[string]interface{})
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			if propertySchema, ok := properties[key].(map[string]interface{}); ok {
+				problems = append(problems, validateJSONSchema(propertySchema, v[key], jsonPath(path, key))...)
+				continue
+			}
+			switch additional := schema["additionalProperties"].(type) {
+			case bool:
+				if !additional {
+					problems = append(problems, fmt.Sprintf("%s: unexpected property %s", location, key))
+				}
+			case map[string]interface{}:
+				problems = append(problems, validateJSONSchema(additional, v[key], jsonPath(path, key))...)
+			}
+		}
+	case []interface{}:
+		if items, ok := schema["items"].(map[string]interface{}); ok {
+			for i, item := range v {
+				problems = append(problems, validateJSONSchema(items, item, fmt.Sprintf("%s[%d]", path, i))...)
+			}
+		}
+	case float64:
+		if minimum, ok := schema["minimum"].(float64); ok && v < minimum {
+			problems = append(problems, fmt.Sprintf("%s: %v is less than the minimum of %v", location, v, minimum))
+		}
+		if maximum, ok := schema["maximum"].(float64); ok && v > maximum {
+			problems = append(problems, fmt.Sprintf("%s: %v is greater than the maximum of %v", location, v, maximum))
+		}
+	case string:
+		if minLength, ok := schema["minLength"].(float64); ok && float64(utf8.RuneCountInString(v)) < minLength {
+			problems = append(problems, fmt.Sprintf("%s: must be at least %v characters", location, minLength))
+		}
+		if maxLength, ok := schema["maxLength"].(float64); ok && float64(utf8.RuneCountInString(v)) > maxLength {
+			problems = append(problems, fmt.Sprintf("%s: must be at most %v characters", location, maxLength))
+		}
+		if pattern, ok := schema["pattern"].(string); ok {
+			if re, err := regexp.Compile(pattern); err == nil && !re.MatchString(v) {
+				problems = append(problems, fmt.Sprintf("%s: does not match pattern %s", location, pattern))
+			}
+		}
+	}
+
+	return problems
+}
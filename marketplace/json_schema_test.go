@@ -0,0 +1,76 @@
+package marketplace
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+const testConfigurationSchema = `{
+  "type": "object",
+  "required": ["program", "rules"],
+  "additionalProperties": false,
+  "properties": {
+    "program": {"type": "string", "enum": ["gym", "yoga"]},
+    "rules": {
+      "type": "array",
+      "items": {
+        "type": "object",
+        "properties": {"max": {"type": "integer", "minimum": 1}}
+      }
+    }
+  }
+}`
+
+func decodeJSON(t *testing.T, document string) interface{} {
+	var value interface{}
+	if err := json.Unmarshal([]byte(document), &value); err != nil {
+		t.Fatal(err)
+	}
+	return value
+}
+
+func TestValidateJSONSchema(t *testing.T) {
+	schema := decodeJSON(t, testConfigurationSchema).(map[string]interface{})
+
+	cases := []struct {
+		configuration string
+		problems      []string
+	}{
+		{`{"program": "gym", "rules": [{"max": 2}]}`, []string{}},
+		{`{"program": "swim", "rules": []}`, []string{"configuration.program: value is not one of the allowed values"}},
+		{`{"rules": [{"max": 0}], "extra": true}`, []string{
+			"configuration: missing required property program",
+			"configuration: unexpected property extra",
+			"configuration.rules[0].max: 0 is less than the minimum of 1",
+		}},
+		{`[]`, []string{"configuration: expected object, got array"}},
+	}
+
+	for _, c := range cases {
+		problems := validateJSONSchema(schema, decodeJSON(t, c.configuration), "")
+		if !reflect.DeepEqual(problems, c.problems) {
+			t.Errorf("validating %s: expected %q, got %q", c.configuration, c.problems, problems)
+		}
+	}
+}
+
+func TestValidateJSONSchemaStringLength(t *testing.T) {
+	schema := decodeJSON(t, `{"type": "string", "minLength": 2, "maxLength": 3}`).(map[string]interface{})
+	cases := map[string]int{"né": 0, "héé": 0, "é": 1, "ééééé": 1}
+	for value, expected := range cases {
+		if problems := validateJSONSchema(schema, value, ""); len(problems) != expected {
+			t.Errorf("%q: expected %d problems, got %q", value, expected, problems)
+		}
+	}
+}
+
+func TestNormalizeJSON(t *testing.T) {
+	normalized, err := normalizeJSON("{\n  \"b\": 1,\n  \"a\": [true, null]\n}")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if normalized != `{"a":[true,null],"b":1}` {
+		t.Errorf("unexpected normalized JSON %s", normalized)
+	}
+}
@@ -0,0 +1,57 @@
+package marketplace
+
+import (
+	"crypto/sha1"
+	"fmt"
+	"sync"
+)
+
+// moduleIdNamespace is the UUIDv5 namespace for module ids derived by the provider
+var moduleIdNamespace = [16]byte{0x6b, 0x2f, 0x0e, 0x63, 0x5d, 0x8c, 0x4f, 0x1a, 0x9e, 0x41, 0x27, 0xd3, 0x8b, 0x55, 0xc0, 0x7e}
+
+// uuidV5 returns the RFC 4122 name based UUID of name in namespace
+func uuidV5(namespace [16]byte, name string) string {
+	hash := sha1.New()
+	hash.Write(namespace[:])
+	hash.Write([]byte(name))
+	sum := hash.Sum(nil)
+
+	sum[6] = (sum[6] & 0x0f) | 0x50
+	sum[8] = (sum[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", sum[0:4], sum[4:6], sum[6:8], sum[8:10], sum[10:16])
+}
+
+// deterministicModuleId derives the id of an app tile module from the provider's module_id_seed,
+// typically the workspace, the app tile id and the resource's module_id_key, so that a retried
+// create reuses the same id. A replacement reuses it too, which is why create_before_destroy can't
+// be used with derived ids.
+func deterministicModuleId(seed string, appTileId string, key string) string {
+	name := seed + "/" + appTileId
+	if key != "" {
+		name += "/" + key
+	}
+	return uuidV5(moduleIdNamespace, name)
+}
+
+// moduleIdClaims records which app tile configuration planned each derived module id, so that two
+// app tiles deriving the same id fail the plan instead of adopting each other's module. The zero
+// value is ready to use.
+type moduleIdClaims struct {
+	mutex  sync.Mutex
+	claims map[string]string
+}
+
+// claim records that the configuration identified by owner uses the module id, and fails when a
+// different configuration already does
+func (claims *moduleIdClaims) claim(moduleId string, owner string) error {
+	claims.mutex.Lock()
+	defer claims.mutex.Unlock()
+	if claims.claims == nil {
+		claims.claims = map[string]string{}
+	}
+	if existing, ok := claims.claims[moduleId]; ok && existing != owner {
+		return fmt.Errorf("another app_tile derives the same module id %s from module_id_seed and this app_tile_id, set a different module_id_key on one of them", moduleId)
+	}
+	claims.claims[moduleId] = owner
+	return nil
+}
@@ -0,0 +1,37 @@
+package marketplace
+
+import "testing"
+
+func TestUuidV5(t *testing.T) {
+	// Example from RFC 4122 errata 1352, the DNS namespace with www.example.com
+	dns := [16]byte{0x6b, 0xa7, 0xb8, 0x10, 0x9d, 0xad, 0x11, 0xd1, 0x80, 0xb4, 0x00, 0xc0, 0x4f, 0xd4, 0x30, 0xc8}
+	if id := uuidV5(dns, "www.example.com"); id != "2ed6657d-e927-568b-95e1-2665a8aea6a2" {
+		t.Errorf("unexpected uuid %s", id)
+	}
+
+	if deterministicModuleId("prod", "tile", "") != deterministicModuleId("prod", "tile", "") {
+		t.Error("expected the same seed and app tile id to give the same module id")
+	}
+	if deterministicModuleId("prod", "tile", "") == deterministicModuleId("staging", "tile", "") {
+		t.Error("expected different seeds to give different module ids")
+	}
+	if deterministicModuleId("prod", "tile", "") == deterministicModuleId("prod", "tile", "second") {
+		t.Error("expected different keys to give different module ids")
+	}
+}
+
+func TestModuleIdClaims(t *testing.T) {
+	var claims moduleIdClaims
+	if err := claims.claim("module", "first"); err != nil {
+		t.Fatal(err)
+	}
+	if err := claims.claim("module", "first"); err != nil {
+		t.Errorf("expected the same configuration to claim its id again, got %s", err)
+	}
+	if err := claims.claim("module", "second"); err == nil {
+		t.Error("expected a second configuration deriving the same id to fail")
+	}
+	if err := claims.claim("other", "second"); err != nil {
+		t.Error(err)
+	}
+}
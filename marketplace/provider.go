@@ -5,15 +5,112 @@ import (
 )
 
 func providerConfigure(d *schema.ResourceData) (interface{}, error) {
-	return BuildAppStoreClient()
+	client, err := BuildAppStoreClient()
+	if err != nil {
+		return nil, err
+	}
+	client.strictTags = d.Get("strict_tags").(bool)
+	client.moduleIdSeed = d.Get("module_id_seed").(string)
+	client.iconLimits = expandImageLimits(d.Get("icon_requirements").([]interface{}))
+	client.previewLimits = expandImageLimits(d.Get("preview_image_requirements").([]interface{}))
+	return client, nil
+}
+
+// expandImageLimits returns the configured image requirements, nothing is enforced without them
+func expandImageLimits(raw []interface{}) imageLimits {
+	if len(raw) == 0 || raw[0] == nil {
+		return imageLimits{}
+	}
+	requirements := raw[0].(map[string]interface{})
+	return imageLimits{
+		MaxBytes:  requirements["max_bytes"].(int),
+		MinWidth:  requirements["min_width"].(int),
+		MinHeight: requirements["min_height"].(int),
+		MaxWidth:  requirements["max_width"].(int),
+		MaxHeight: requirements["max_height"].(int),
+		Square:    requirements["square"].(bool),
+	}
+}
+
+func imageRequirementsSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: description,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"max_bytes": {
+					Type:        schema.TypeInt,
+					Optional:    true,
+					Description: "0 disables the limit",
+				},
+				"min_width": {
+					Type:     schema.TypeInt,
+					Optional: true,
+				},
+				"min_height": {
+					Type:     schema.TypeInt,
+					Optional: true,
+				},
+				"max_width": {
+					Type:        schema.TypeInt,
+					Optional:    true,
+					Description: "0 disables the limit",
+				},
+				"max_height": {
+					Type:        schema.TypeInt,
+					Optional:    true,
+					Description: "0 disables the limit",
+				},
+				"square": {
+					Type:     schema.TypeBool,
+					Optional: true,
+				},
+			},
+		},
+	}
 }
 
 func Provider() *schema.Provider {
 	return &schema.Provider{
 		ConfigureFunc: providerConfigure,
-		Schema:        map[string]*schema.Schema{},
+		Schema: map[string]*schema.Schema{
+			"strict_tags": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Reject module tags that are not already in the marketplace tag catalog unless they are listed in allowed_new_tags",
+			},
+			"icon_requirements":          imageRequirementsSchema("Limits every app_tile icon is checked against during plan, nothing is checked without them"),
+			"preview_image_requirements": imageRequirementsSchema("Limits every app_tile preview image is checked against during plan, nothing is checked without them"),
+			"module_id_seed": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Give app tiles without a module_id a UUIDv5 derived from this seed and their app_tile_id, so that a create retried after a crash adopts the module instead of duplicating it",
+			},
+		},
 		ResourcesMap: map[string]*schema.Resource{
-			"app_tile":          appTileResource(),
+			"app_tile":                               appTileResource(),
+			"marketplace_install":                    installResource(),
+			"marketplace_wellness_offering_install":  wellnessOfferingInstallResource(),
+			"marketplace_program_enrollment_install": programEnrollmentInstallResource(),
+			"marketplace_publish_review_decision":    publishReviewDecisionResource(),
+			"marketplace_review_reply":               reviewReplyResource(),
+		},
+		DataSourcesMap: map[string]*schema.Resource{
+			"marketplace_installs":               installsDataSource(),
+			"marketplace_module_publish_reviews": modulePublishReviewsDataSource(),
+			"marketplace_review_queue":           reviewQueueDataSource(),
+			"marketplace_module_reviews":         moduleReviewsDataSource(),
+			"marketplace_module_rating":          moduleRatingDataSource(),
+			"marketplace_stats":                  statsDataSource(),
+			"marketplace_tags":                   tagsDataSource(),
+			"marketplace_purchases":              purchasesDataSource(),
+			"marketplace_module_purchase":        modulePurchaseDataSource(),
+			"marketplace_connect_account":        connectAccountDataSource(),
+			"marketplace_connect_account_link":   connectAccountLinkDataSource(),
+			"marketplace_billing_portal_link":    billingPortalLinkDataSource(),
 		},
 	}
 }
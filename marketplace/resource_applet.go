@@ -1,141 +1,933 @@
 package marketplace
 
 import (
-	"bytes"
 	"context"
-	"crypto/md5"
+	"crypto/sha256"
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"io"
 	"log"
-	"net/http"
+	"net/url"
+	"strings"
 	"time"
 
 	"github.com/coreos/go-semver/semver"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
-func getHash(url string) (*string, error) {
-	res, err := http.Get(url)
+// hashImage returns the hex encoded SHA-256 of the prepared image, or an empty string when there is no image
+func hashImage(image *preparedImage) string {
+	if image == nil {
+		return ""
+	}
+	hash := sha256.Sum256(image.Content)
+	return hex.EncodeToString(hash[:])
+}
+
+// checkPlannedImageHash fails the apply when an image no longer hashes to the value computed during
+// plan, for example because the file or the content behind image_url changed in between. Unknown
+// planned hashes read as empty and aren't checked.
+func checkPlannedImageHash(d *schema.ResourceData, key string, hash string) error {
+	planned := d.Get(key).(string)
+	if planned != "" && planned != hash {
+		return fmt.Errorf("the images changed after the plan: %s was planned as %s but is now %s, run terraform plan again", key, planned, hash)
+	}
+	return nil
+}
 
+// publishedImageUrl identifies a published image by its URL without the query string, which
+// presigned URLs change on every read
+func publishedImageUrl(raw string) string {
+	parsed, err := url.Parse(raw)
 	if err != nil {
-		return nil, err
+		return raw
+	}
+	parsed.RawQuery = ""
+	parsed.Fragment = ""
+	return parsed.String()
+}
+
+// iconUrl returns the published URL of an icon, or an empty string when there is no icon
+func iconUrl(icon *AppTileModuleIconV2MarketplaceModuleImage) string {
+	if icon == nil {
+		return ""
 	}
-	body := &bytes.Buffer{}
-	_, err = body.ReadFrom(res.Body)
+	return publishedImageUrl(icon.Url)
+}
+
+// previewImageUrls returns the published URLs of the preview images in order
+func previewImageUrls(app *AppTileModule) []string {
+	urls := []string{}
+	if app.PreviewImagesV2 == nil {
+		return urls
+	}
+	for _, image := range app.PreviewImagesV2.Images {
+		urls = append(urls, publishedImageUrl(image.Url))
+	}
+	return urls
+}
+
+// hashPublishedImage downloads a published image and hashes it the same way as an uploaded image
+func hashPublishedImage(url string) (string, error) {
+	reader, err := urlImageSource{Url: url}.Open()
 	if err != nil {
-		return nil, err
+		return "", err
+	}
+	defer reader.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, reader); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// hashPreviewImages combines the hash and description of each preview image in order, or returns
+// an empty string when there are no preview images
+func hashPreviewImages(hashes []string, descriptions []string) string {
+	if len(hashes) == 0 {
+		return ""
+	}
+	hash := sha256.New()
+	for i := range hashes {
+		fmt.Fprintf(hash, "%s %q\n", hashes[i], descriptions[i])
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+func hashPreparedPreviewImages(images []previewImage) string {
+	hashes := make([]string, 0, len(images))
+	descriptions := make([]string, 0, len(images))
+	for _, image := range images {
+		hashes = append(hashes, hashImage(image.Image))
+		descriptions = append(descriptions, image.Description)
+	}
+	return hashPreviewImages(hashes, descriptions)
+}
+
+// refreshImageHash sets image_hash to the hash of the published icon. The icon is only downloaded
+// when its URL differs from icon_url, which happens after an import, an upgrade from a version
+// that stored MD5 hashes, or when the icon was replaced outside of terraform.
+func refreshImageHash(d *schema.ResourceData, app *AppTileModule) error {
+	if app.IconV2 == nil {
+		// The icon is gone, so the next plan uploads the image again
+		d.Set("image_hash", "")
+		d.Set("icon_url", "")
+		return nil
+	}
+
+	published := iconUrl(app.IconV2)
+	if published == d.Get("icon_url").(string) {
+		return nil
+	}
+	hash, err := hashPublishedIcon(app)
+	if err != nil {
+		return fmt.Errorf("failed to hash the icon of module %s: %w", d.Id(), err)
+	}
+	d.Set("image_hash", hash)
+	d.Set("icon_url", published)
+	return nil
+}
+
+// refreshPreviewImagesHash sets preview_images_hash like refreshImageHash does for the icon. Preview
+// images are only compared once terraform has uploaded some, so preview images added in the
+// marketplace to a module that never configured any are left alone.
+func refreshPreviewImagesHash(d *schema.ResourceData, app *AppTileModule) error {
+	if d.Get("preview_images_hash").(string) == "" {
+		return nil
+	}
+
+	published := previewImageUrls(app)
+	if strings.Join(published, "\n") == strings.Join(expandStringList(d.Get("preview_image_urls").([]interface{})), "\n") {
+		return nil
+	}
+
+	hash, err := hashPublishedPreviewImages(app)
+	if err != nil {
+		return fmt.Errorf("failed to hash the preview images of module %s: %w", d.Id(), err)
+	}
+	d.Set("preview_images_hash", hash)
+	d.Set("preview_image_urls", published)
+	return nil
+}
+
+// hashPublishedIcon downloads and hashes the published icon, or returns an empty string when there is none
+func hashPublishedIcon(app *AppTileModule) (string, error) {
+	if app.IconV2 == nil {
+		return "", nil
+	}
+	return hashPublishedImage(app.IconV2.Url)
+}
+
+// hashPublishedPreviewImages downloads the published preview images and hashes them like hashPreviewImages
+func hashPublishedPreviewImages(app *AppTileModule) (string, error) {
+	hashes := []string{}
+	descriptions := []string{}
+	if app.PreviewImagesV2 == nil {
+		return "", nil
+	}
+	for _, image := range app.PreviewImagesV2.Images {
+		hash, err := hashPublishedImage(image.Url)
+		if err != nil {
+			return "", err
+		}
+		hashes = append(hashes, hash)
+		descriptions = append(descriptions, image.Description)
 	}
+	return hashPreviewImages(hashes, descriptions), nil
+}
+
+type imageGetter interface {
+	Get(key string) interface{}
+}
 
-	hash := md5.Sum(body.Bytes())
-	text := hex.EncodeToString(hash[:])
-	return &text, nil
+func appTileImageOptions(d imageGetter, limits imageLimits) imageOptions {
+	return imageOptions{
+		Limits:       limits,
+		Resize:       d.Get("image_resize").(bool),
+		ConvertToPNG: d.Get("image_convert_to_png").(bool),
+	}
+}
+
+// appTileImageSource returns wherever the image is configured to come from, or nil when there is no image
+func appTileImageSource(d imageGetter) imageSource {
+	if path := d.Get("image").(string); path != "" {
+		return fileImageSource{Path: path}
+	}
+	if data := d.Get("image_base64").(string); data != "" {
+		return base64ImageSource{Data: data}
+	}
+	if url := d.Get("image_url").(string); url != "" {
+		return urlImageSource{Url: url}
+	}
+	return nil
+}
+
+// prepareAppTileImage validates and normalizes the image exactly as it will be uploaded
+func prepareAppTileImage(d imageGetter, client *MarketplaceClient) (*preparedImage, error) {
+	source := appTileImageSource(d)
+	if source == nil {
+		return nil, nil
+	}
+	return prepareImage(source, appTileImageOptions(d, client.iconLimits))
+}
+
+// prepareAppTilePreviewImages validates and normalizes the preview images exactly as they will be uploaded
+func prepareAppTilePreviewImages(d imageGetter, client *MarketplaceClient) ([]previewImage, error) {
+	options := appTileImageOptions(d, client.previewLimits)
+	images := []previewImage{}
+	for i, raw := range d.Get("preview_image").([]interface{}) {
+		preview := raw.(map[string]interface{})
+		image, err := prepareImage(fileImageSource{Path: preview["image"].(string)}, options)
+		if err != nil {
+			return nil, fmt.Errorf("preview_image %d: %w", i+1, err)
+		}
+		images = append(images, previewImage{Image: image, Description: preview["description"].(string)})
+	}
+	return images, nil
+}
+
+// refreshPendingReview clears pending_review_id once the review has been decided and
+// reports whether a review is still pending
+func refreshPendingReview(d *schema.ResourceData, client *MarketplaceClient) (bool, error) {
+	reviewId := d.Get("pending_review_id").(string)
+	if reviewId == "" {
+		return false, nil
+	}
+
+	review, err := client.getPublishReview(d.Id(), reviewId)
+	if isNotFound(err) {
+		d.Set("pending_review_id", "")
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	if !isPendingReview(review.Status) {
+		d.Set("pending_review_id", "")
+		return false, nil
+	}
+	return true, nil
+}
+
+// waitForAppTilePublish waits until a direct publish is readable and records the URLs of the images
+// that were just uploaded, so they aren't downloaded again to hash them. Publishes that go through review
+// aren't visible until they are approved, so there is nothing to wait for.
+func waitForAppTilePublish(d *schema.ResourceData, client *MarketplaceClient, publish *appTilePublish, timeout string) error {
+	if publish.PublishReviewId != "" {
+		return nil
+	}
+	app, err := waitForAppTile(client, publish.Id, d.Get("version").(string), d.Timeout(timeout))
+	if err != nil {
+		return err
+	}
+	d.Set("icon_url", iconUrl(app.IconV2))
+	d.Set("preview_image_urls", previewImageUrls(app))
+	return nil
+}
+
+// pruneAppTileVersions enforces retain_versions
+func pruneAppTileVersions(d *schema.ResourceData, client *MarketplaceClient) error {
+	retain := d.Get("retain_versions").(int)
+	if retain == 0 {
+		return nil
+	}
+
+	deleted, err := client.pruneModuleVersions(d.Id(), retain)
+	if len(deleted) > 0 {
+		log.Printf("Deleted versions %s of module %s", strings.Join(deleted, ", "), d.Id())
+	}
+	return err
+}
+
+// pruneAppTileVersionsAfterPublish enforces retain_versions after a publish. The new version is
+// already published by then, so a failed prune is only logged instead of failing the apply.
+func pruneAppTileVersionsAfterPublish(d *schema.ResourceData, client *MarketplaceClient) {
+	if err := pruneAppTileVersions(d, client); err != nil {
+		log.Printf("[WARN] Module %s was published, but its old versions could not be pruned: %s", d.Id(), err)
+	}
 }
 
 func readAppTile(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*MarketplaceClient)
 	id := d.Id()
-	var app *AppTileModule
-	retryCount := 2
-	for app == nil && retryCount > 0 {
-		inner, err := client.getAppTileModule(id)
-		app = inner
+
+	pending, err := refreshPendingReview(d, client)
+	if err != nil {
+		return err
+	}
+
+	app, err := client.getAppTileModule(id)
+	if isNotFound(err) {
+		if pending {
+			// A first publish isn't visible until its review is approved
+			return nil
+		}
+		if !d.IsNewResource() {
+			log.Printf("Module %s no longer exists, removing from state", id)
+			d.SetId("")
+			return nil
+		}
+		// The module was only just created or adopted, so wait for eventual consistency
+		app, err = waitForAppTile(client, id, "", d.Timeout(schema.TimeoutRead))
+	}
+	if err != nil {
+		return err
+	}
+
+	d.Set("module_id", id)
+	// The published module is still the version before the review, so the state keeps the version
+	// waiting for review instead of showing a diff that would cancel and resubmit it
+	if !pending {
+		if err := refreshImageHash(d, app); err != nil {
+			return err
+		}
+		if err := refreshPreviewImagesHash(d, app); err != nil {
+			return err
+		}
+
+		d.Set("name", app.Title)
+		d.Set("description", app.Description)
+		d.Set("version", app.Version)
+		d.Set("tags", app.Tags)
+		d.Set("price", flattenPrices(app.Prices))
+		if source, ok := app.Source.(*AppTileModuleSourceAppTile); ok {
+			d.Set("app_tile_id", source.Id)
+		}
+	}
+
+	if d.Get("include_publish_reviews").(bool) {
+		reviews, err := client.listPublishReviews(id)
 		if err != nil {
 			return err
 		}
-		if app == nil {
-			// Sometimes with eventual consistency the module isn't created yet
-			log.Println("Module not found, trying again in 5 seconds...")
-			time.Sleep(5 * time.Second)
+		d.Set("publish_reviews", flattenPublishReviews(reviews))
+	} else {
+		d.Set("publish_reviews", nil)
+	}
+	return nil
+}
+
+// adoptAppTile returns the module that already exists with the requested id, which happens when an
+// earlier apply created it but stopped before saving the state, or nil when there is none. A module
+// that isn't this app tile is refused rather than taken over.
+func adoptAppTile(d *schema.ResourceData, client *MarketplaceClient, moduleId string) (*AppTileModule, error) {
+	app, err := client.getAppTileModule(moduleId)
+	if err == nil {
+		appTileId := d.Get("app_tile_id").(string)
+		if source, ok := app.Source.(*AppTileModuleSourceAppTile); !ok || source.Id != appTileId {
+			return nil, fmt.Errorf("module %s already exists but isn't a module of app tile %s, set a different module_id", moduleId, appTileId)
 		}
-		retryCount -= 1
+		log.Printf("Module %s already exists, adopting it instead of creating it", moduleId)
+		return app, nil
+	}
+	if !isNotFound(err) {
+		return nil, err
+	}
+
+	// An apply that stopped before publishing leaves a draft behind that would block the id. It is
+	// only deleted when it was created from this configuration.
+	draft, err := GetDraftModule(context.Background(), client.gqlClient, moduleId)
+	if isNotFound(err) {
+		return nil, nil
 	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the unpublished draft of module %s: %w", moduleId, err)
+	}
+	if draft.DraftModule.ParentModuleId != "" || draft.DraftModule.Title != d.Get("name").(string) || draft.DraftModule.Description != d.Get("description").(string) {
+		return nil, fmt.Errorf("module %s has an unpublished draft that wasn't created from this configuration, delete it or set a different module_id", moduleId)
+	}
+	if _, err := DeleteDraftModule(context.Background(), client.gqlClient, moduleId); err != nil && !isNotFound(err) {
+		return nil, fmt.Errorf("failed to delete the unpublished draft of module %s left by an earlier apply: %w", moduleId, err)
+	}
+	log.Printf("Deleted the unpublished draft of module %s left by an earlier apply", moduleId)
+	return nil, nil
+}
 
-	if app == nil {
-		return errors.New("no Module Found")
+// adoptedAppTileChanges lists the attributes whose planned values differ from the adopted module
+func adoptedAppTileChanges(d *schema.ResourceData, app *AppTileModule) ([]string, error) {
+	moduleId := d.Get("module_id").(string)
+	changes := []string{}
+	if d.Get("name").(string) != app.Title {
+		changes = append(changes, "name")
+	}
+	if d.Get("description").(string) != app.Description {
+		changes = append(changes, "description")
+	}
+	if !d.Get("tags").(*schema.Set).Equal(schema.NewSet(schema.HashString, stringsToInterfaces(app.Tags))) {
+		changes = append(changes, "tags")
+	}
+	prices := expandPrices(d.Get("price").([]interface{}))
+	if len(prices) != len(app.Prices) {
+		changes = append(changes, "price")
+	} else {
+		for i, price := range prices {
+			if price.Amount != app.Prices[i].Amount || price.Interval != app.Prices[i].Interval {
+				changes = append(changes, "price")
+				break
+			}
+		}
 	}
 
-	if app.IconV2 != nil {
-		hash, err := getHash(app.IconV2.Url)
+	iconHash, err := hashPublishedIcon(app)
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash the icon of module %s: %w", moduleId, err)
+	}
+	if iconHash != d.Get("image_hash").(string) {
+		changes = append(changes, "image")
+	}
+	if planned := d.Get("preview_images_hash").(string); planned != "" {
+		previewHash, err := hashPublishedPreviewImages(app)
 		if err != nil {
+			return nil, fmt.Errorf("failed to hash the preview images of module %s: %w", moduleId, err)
+		}
+		if previewHash != planned {
+			changes = append(changes, "preview_image")
+		}
+	}
+	return changes, nil
+}
+
+func stringsToInterfaces(values []string) []interface{} {
+	result := make([]interface{}, 0, len(values))
+	for _, value := range values {
+		result = append(result, value)
+	}
+	return result
+}
+
+// publishAppTile publishes the planned configuration as a new module, or as a new version of
+// parentModuleId when that is set
+func publishAppTile(d *schema.ResourceData, client *MarketplaceClient, parentModuleId *string, removeIcon bool, replacePreviewImages bool, timeout string) error {
+	image, err := prepareAppTileImage(d, client)
+	if err != nil {
+		return err
+	}
+	previewImages, err := prepareAppTilePreviewImages(d, client)
+	if err != nil {
+		return err
+	}
+	if err := checkPlannedImageHash(d, "image_hash", hashImage(image)); err != nil {
+		return err
+	}
+	if err := checkPlannedImageHash(d, "preview_images_hash", hashPreparedPreviewImages(previewImages)); err != nil {
+		return err
+	}
+	prices := expandPrices(d.Get("price").([]interface{}))
+	if err := client.checkPricesPublishable(prices); err != nil {
+		return err
+	}
+
+	// A review still waiting for the previous version is only cancelled once nothing is left that
+	// could stop the new version from being published
+	if reviewId := d.Get("pending_review_id").(string); parentModuleId != nil && reviewId != "" {
+		if err := client.cancelPendingPublishReview(*parentModuleId, reviewId); err != nil {
 			return err
 		}
-		d.Set("image_hash", hash)
-	} else {
-		d.Set("image_hash", nil)
 	}
 
-	d.Set("name", app.Title)
-	d.Set("description", app.Description)
-	d.Set("version", app.Version)
-	if source, ok := app.Source.(*AppTileModuleSourceAppTile); ok {
-		d.Set("app_tile_id", source.Id)
+	moduleId := ""
+	if parentModuleId == nil {
+		moduleId = d.Get("module_id").(string)
+	}
+	publish, err := client.publishNewAppTileModule(appTileCreate{
+		Name:                 d.Get("name").(string),
+		ModuleId:             moduleId,
+		Image:                image,
+		RemoveIcon:           image == nil && removeIcon,
+		PreviewImages:        previewImages,
+		ReplacePreviewImages: replacePreviewImages,
+		AppTileId:            d.Get("app_tile_id").(string),
+		Description:          d.Get("description").(string),
+		Version:              d.Get("version").(string),
+		ParentModuleId:       parentModuleId,
+		Review:               d.Get("publish_review").(bool),
+		Tags:                 expandStringSet(d.Get("tags").(*schema.Set)),
+		Prices:               prices,
+	})
+	if err != nil {
+		return err
 	}
+	if parentModuleId == nil {
+		d.SetId(publish.Id)
+	}
+	d.Set("pending_review_id", publish.PublishReviewId)
+	d.Set("image_hash", hashImage(image))
+	d.Set("preview_images_hash", hashPreparedPreviewImages(previewImages))
+	if err := waitForAppTilePublish(d, client, publish, timeout); err != nil {
+		return err
+	}
+	pruneAppTileVersionsAfterPublish(d, client)
 	return nil
 }
 
 func createAppTile(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*MarketplaceClient)
-	_, versionExists := d.GetOk("version")
-	if !versionExists {
-		if !d.Get("auto_version").(bool) {
-			return errors.New("if you don't specify a version, you must use auto_version")
-		}
-		d.Set("version", "0.0.0")
-	}
-	id, err := client.publishNewAppTileModule(appTileCreate{
-		Name:           d.Get("name").(string),
-		Image:          d.Get("image").(string),
-		AppTileId:      d.Get("app_tile_id").(string),
-		Description:    d.Get("description").(string),
-		Version:        d.Get("version").(string),
-		ParentModuleId: nil,
-	})
+	moduleId := d.Get("module_id").(string)
+	if moduleId != "" {
+		adopted, err := adoptAppTile(d, client, moduleId)
+		if err != nil {
+			return err
+		}
+		if adopted != nil {
+			return createFromAdoptedAppTile(d, meta, adopted)
+		}
+	}
+
+	previewImages := d.Get("preview_image").([]interface{})
+	if err := publishAppTile(d, client, nil, false, len(previewImages) > 0, schema.TimeoutCreate); err != nil {
+		return err
+	}
+	return readAppTile(d, meta)
+}
+
+// createFromAdoptedAppTile keeps an adopted module that already matches the plan, and otherwise
+// publishes the plan as a new version of it. A module that differs at the planned version can't
+// be published again, so that fails instead.
+func createFromAdoptedAppTile(d *schema.ResourceData, meta interface{}, adopted *AppTileModule) error {
+	client := meta.(*MarketplaceClient)
+	moduleId := d.Get("module_id").(string)
+	changes, err := adoptedAppTileChanges(d, adopted)
 	if err != nil {
 		return err
 	}
-	d.SetId(*id)
+
+	version := d.Get("version").(string)
+	if adopted.Version == version {
+		if len(changes) > 0 {
+			return fmt.Errorf("module %s already exists at version %s with a different %s, increase version to publish the configuration", moduleId, version, strings.Join(changes, ", "))
+		}
+		d.SetId(moduleId)
+		return readAppTile(d, meta)
+	}
+
+	log.Printf("Publishing version %s of adopted module %s", version, moduleId)
+	d.SetId(moduleId)
+	replacePreviewImages := len(d.Get("preview_image").([]interface{})) > 0
+	if err := publishAppTile(d, client, &moduleId, adopted.IconV2 != nil, replacePreviewImages, schema.TimeoutCreate); err != nil {
+		return err
+	}
 	return readAppTile(d, meta)
 }
 
 func updateAppTile(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*MarketplaceClient)
 	id := d.Id()
-	if d.Get("auto_version").(bool) {
-		version, err := semver.NewVersion(d.Get("version").(string))
-		if err != nil {
-			return err
-		}
-		version.BumpPatch()
-		d.Set("version", version.String())
+	if !d.HasChanges(appTilePublishKeys...) {
+		return pruneAppTileVersions(d, client)
 	}
 
-	_, err := client.publishNewAppTileModule(appTileCreate{
-		Name:           d.Get("name").(string),
-		Image:          d.Get("image").(string),
-		AppTileId:      d.Get("app_tile_id").(string),
-		Description:    d.Get("description").(string),
-		Version:        d.Get("version").(string),
-		ParentModuleId: &id,
-	})
-	return err
+	previousHash, _ := d.GetChange("image_hash")
+	return publishAppTile(d, client, &id, previousHash.(string) != "", d.HasChange("preview_images_hash"), schema.TimeoutUpdate)
 }
 
 func deleteAppTile(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*MarketplaceClient).gqlClient
+	marketplace := meta.(*MarketplaceClient)
+	client := marketplace.gqlClient
 	id := d.Id()
 
+	if d.Get("deletion_protection").(bool) {
+		return fmt.Errorf("module %s has deletion_protection enabled, set deletion_protection = false and apply before destroying it", id)
+	}
+
+	if d.Get("check_usage_before_delete").(bool) && !d.Get("force_delete").(bool) {
+		if err := marketplace.checkModuleUnused(id); err != nil {
+			return err
+		}
+	}
+
+	if reviewId := d.Get("pending_review_id").(string); reviewId != "" {
+		if err := marketplace.cancelPendingPublishReview(id, reviewId); err != nil {
+			return err
+		}
+	}
+
 	if _, err := DeleteModule(context.Background(), client, DeleteModuleInput{ModuleId: id}); err != nil {
 		return fmt.Errorf("failed to delete module %s: %w", id, err)
 	}
+	if err := waitForAppTileDeleted(marketplace, id, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return err
+	}
 
 	d.SetId("")
 	return nil
 }
 
+func expandStringSet(set *schema.Set) []string {
+	values := []string{}
+	for _, value := range set.List() {
+		values = append(values, value.(string))
+	}
+	return values
+}
+
+func expandStringList(list []interface{}) []string {
+	values := []string{}
+	for _, value := range list {
+		values = append(values, value.(string))
+	}
+	return values
+}
+
+func expandPrices(raw []interface{}) []DraftModulePriceInput {
+	prices := []DraftModulePriceInput{}
+	for _, item := range raw {
+		price := item.(map[string]interface{})
+		prices = append(prices, DraftModulePriceInput{
+			Amount:   price["amount"].(int),
+			Interval: PaymentInterval(price["interval"].(string)),
+		})
+	}
+	return prices
+}
+
+func flattenPrices(prices []AppTileModulePricesModulePrice) []map[string]interface{} {
+	flattened := make([]map[string]interface{}, 0, len(prices))
+	for _, price := range prices {
+		flattened = append(flattened, map[string]interface{}{
+			"amount":   price.Amount,
+			"interval": string(price.Interval),
+		})
+	}
+	return flattened
+}
+
+// checkStrictTags rejects tags missing from the tag catalog when the provider enables strict_tags
+func checkStrictTags(d *schema.ResourceDiff, client *MarketplaceClient) error {
+	if !client.strictTags || !d.HasChange("tags") || !d.NewValueKnown("tags") {
+		return nil
+	}
+
+	catalog, err := client.tagCatalog()
+	if err != nil {
+		return fmt.Errorf("failed to load the tag catalog: %w", err)
+	}
+
+	allowed := d.Get("allowed_new_tags").(*schema.Set)
+	unknown := []string{}
+	for _, tag := range expandStringSet(d.Get("tags").(*schema.Set)) {
+		if !catalog[tag] && !allowed.Contains(tag) {
+			unknown = append(unknown, tag)
+		}
+	}
+	if len(unknown) > 0 {
+		return fmt.Errorf("tags %s are not in the tag catalog, fix the spelling or add them to allowed_new_tags", strings.Join(unknown, ", "))
+	}
+	return nil
+}
+
+var imageSourceKeys = []string{"image", "image_base64", "image_url"}
+
+// planImageHash validates the local image and hashes it so that changing its content triggers an update
+func planImageHash(d *schema.ResourceDiff, client *MarketplaceClient) error {
+	for _, key := range imageSourceKeys {
+		if !d.NewValueKnown(key) {
+			return d.SetNewComputed("image_hash")
+		}
+	}
+
+	image, err := prepareAppTileImage(d, client)
+	if err != nil {
+		return err
+	}
+	// A configured image_hash, such as the filemd5() older versions required, is ignored
+	hash := hashImage(image)
+	if old, _ := d.GetChange("image_hash"); old.(string) != hash || attributeConfigured(d, "image_hash") {
+		return d.SetNew("image_hash", hash)
+	}
+	return nil
+}
+
+// planPreviewImagesHash validates the preview images and hashes them like planImageHash does for the icon
+func planPreviewImagesHash(d *schema.ResourceDiff, client *MarketplaceClient) error {
+	unknown := !d.NewValueKnown("preview_image")
+	for i := range d.Get("preview_image").([]interface{}) {
+		unknown = unknown || !d.NewValueKnown(fmt.Sprintf("preview_image.%d.image", i)) || !d.NewValueKnown(fmt.Sprintf("preview_image.%d.description", i))
+	}
+	if unknown {
+		return d.SetNewComputed("preview_images_hash")
+	}
+
+	images, err := prepareAppTilePreviewImages(d, client)
+	if err != nil {
+		return err
+	}
+	hash := hashPreparedPreviewImages(images)
+	if old, _ := d.GetChange("preview_images_hash"); old.(string) != hash {
+		return d.SetNew("preview_images_hash", hash)
+	}
+	return nil
+}
+
+// checkPlannedPrices refuses paid prices during plan unless the connect account can take payments
+func checkPlannedPrices(d *schema.ResourceDiff, client *MarketplaceClient) error {
+	if !d.HasChange("price") || !d.NewValueKnown("price") {
+		return nil
+	}
+	return client.checkPricesPublishable(expandPrices(d.Get("price").([]interface{})))
+}
+
+// appTilePublishKeys are the attributes that change the published module. Other changes are
+// applied without publishing a new version.
+var appTilePublishKeys = []string{
+	"name",
+	"description",
+	"image",
+	"image_base64",
+	"image_url",
+	"image_resize",
+	"image_convert_to_png",
+	"image_hash",
+	"preview_image",
+	"preview_images_hash",
+	"app_tile_id",
+	"version",
+	"tags",
+	"price",
+}
+
+func attributeConfigured(d *schema.ResourceDiff, key string) bool {
+	config := d.GetRawConfig()
+	if config.IsNull() || !config.IsKnown() {
+		return false
+	}
+	return !config.GetAttr(key).IsNull()
+}
+
+// appTileVersionBump picks the largest bump needed by the changed attributes, using the first
+// matching auto_version_rule or else auto_version_strategy. It returns "" when nothing is published.
+func appTileVersionBump(d *schema.ResourceDiff) string {
+	rules := d.Get("auto_version_rule").([]interface{})
+	bump := ""
+	for _, key := range appTilePublishKeys {
+		if !d.HasChange(key) {
+			continue
+		}
+
+		keyBump := d.Get("auto_version_strategy").(string)
+		for _, raw := range rules {
+			rule := raw.(map[string]interface{})
+			if rule["attributes"].(*schema.Set).Contains(key) {
+				keyBump = rule["bump"].(string)
+				break
+			}
+		}
+		bump = largerVersionBump(bump, keyBump)
+	}
+	return bump
+}
+
+// planAutoVersion computes the version auto_version will publish so that it shows in the plan.
+// It bumps the latest published version when that is ahead of the state.
+func planAutoVersion(d *schema.ResourceDiff, client *MarketplaceClient) error {
+	if attributeConfigured(d, "version") {
+		return nil
+	}
+	if !d.Get("auto_version").(bool) {
+		return errors.New("if you don't specify a version, you must use auto_version")
+	}
+	moduleId := plannedModuleId(d)
+	if d.Id() == "" && moduleId == "" {
+		return d.SetNew("version", "0.0.0")
+	}
+
+	bump := appTileVersionBump(d)
+	if d.Id() == "" {
+		// A module with this id that already exists is adopted, and its latest version is bumped
+		bump = d.Get("auto_version_strategy").(string)
+	}
+	if bump == "" {
+		return nil
+	}
+	old, _ := d.GetChange("version")
+	current := old.(string)
+	latest, err := client.latestModuleVersion(moduleId)
+	if err != nil {
+		return fmt.Errorf("failed to list the versions of module %s: %w", moduleId, err)
+	}
+	if d.Id() == "" {
+		if latest == nil {
+			return d.SetNew("version", "0.0.0")
+		}
+		current = latest.String()
+	}
+	if parsed, err := semver.NewVersion(current); latest != nil && (err != nil || parsed.LessThan(*latest)) {
+		current = latest.String()
+	}
+
+	next, err := bumpVersion(current, bump)
+	if err != nil {
+		return err
+	}
+	return d.SetNew("version", next)
+}
+
+// checkVersionChanges rejects a plan that publishes without changing the version, which the
+// marketplace refuses as a duplicate. With auto_version this means version is in ignore_changes,
+// which Terraform passes to the provider as if the previous version was configured.
+func checkVersionChanges(d *schema.ResourceDiff) error {
+	if d.Id() == "" || d.HasChange("version") {
+		return nil
+	}
+	changed := []string{}
+	for _, key := range appTilePublishKeys {
+		if key != "version" && d.HasChange(key) {
+			changed = append(changed, key)
+		}
+	}
+	if len(changed) == 0 {
+		return nil
+	}
+
+	if d.Get("auto_version").(bool) {
+		return fmt.Errorf("changing %s publishes a new version, but version is still %s. auto_version can't change a version that is configured or listed in ignore_changes, remove it from both", strings.Join(changed, ", "), d.Get("version"))
+	}
+	return fmt.Errorf("changing %s publishes a new version, so version must be increased from %s", strings.Join(changed, ", "), d.Get("version"))
+}
+
+// checkVersionIncreases rejects a configured version that isn't greater than the latest published
+// one, before any draft is created
+func checkVersionIncreases(d *schema.ResourceDiff, client *MarketplaceClient) error {
+	moduleId := plannedModuleId(d)
+	if moduleId == "" || !attributeConfigured(d, "version") || !d.HasChange("version") || !d.NewValueKnown("version") {
+		return nil
+	}
+
+	version, err := semver.NewVersion(d.Get("version").(string))
+	if err != nil {
+		return err
+	}
+	latest, err := client.latestModuleVersion(moduleId)
+	if err != nil {
+		return fmt.Errorf("failed to list the versions of module %s: %w", moduleId, err)
+	}
+	if latest == nil || latest.LessThan(*version) {
+		return nil
+	}
+	if d.Id() == "" && latest.Equal(*version) {
+		// The module is adopted as it is when it matches the rest of the plan
+		return nil
+	}
+	return fmt.Errorf("version %s must be greater than %s, the latest published version of module %s", version, latest, moduleId)
+}
+
+// plannedModuleId returns the id of the module, including a configured or derived module_id that
+// an existing module would be adopted under, or an empty string when it isn't known yet
+func plannedModuleId(d *schema.ResourceDiff) string {
+	if d.Id() != "" {
+		return d.Id()
+	}
+	if !d.NewValueKnown("module_id") {
+		return ""
+	}
+	return d.Get("module_id").(string)
+}
+
+// planModuleId derives the id of a new module from the provider's module_id_seed. Every app tile
+// using a derived id claims it, so a new app tile can't derive the id of another one.
+func planModuleId(d *schema.ResourceDiff, client *MarketplaceClient) error {
+	if client.moduleIdSeed == "" || attributeConfigured(d, "module_id") {
+		return nil
+	}
+	owner := d.GetRawConfig().GoString()
+	if d.Id() != "" {
+		return client.derivedModuleIds.claim(d.Id(), owner)
+	}
+	if !d.NewValueKnown("app_tile_id") || !d.NewValueKnown("module_id_key") {
+		return nil
+	}
+
+	moduleId := deterministicModuleId(client.moduleIdSeed, d.Get("app_tile_id").(string), d.Get("module_id_key").(string))
+	if err := client.derivedModuleIds.claim(moduleId, owner); err != nil {
+		return err
+	}
+	return d.SetNew("module_id", moduleId)
+}
+
+func customizeAppTileDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	client := meta.(*MarketplaceClient)
+	if err := planModuleId(d, client); err != nil {
+		return err
+	}
+	if err := planImageHash(d, client); err != nil {
+		return err
+	}
+	if err := planPreviewImagesHash(d, client); err != nil {
+		return err
+	}
+	if err := planAutoVersion(d, client); err != nil {
+		return err
+	}
+	if err := checkVersionChanges(d); err != nil {
+		return err
+	}
+	if err := checkVersionIncreases(d, client); err != nil {
+		return err
+	}
+	if err := checkPlannedPrices(d, client); err != nil {
+		return err
+	}
+	return checkStrictTags(d, client)
+}
+
 func appTileResource() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
+			"module_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Id for the new module. An existing module with this id is adopted instead of created.",
+			},
+			"module_id_key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Distinguishes app tiles with the same app_tile_id when module_id_seed derives their module ids",
+			},
 			"name": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -145,30 +937,205 @@ func appTileResource() *schema.Resource {
 				Required: true,
 			},
 			"image": {
-				Type:     schema.TypeString,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "Path to the image file",
+				ConflictsWith: []string{"image_base64", "image_url"},
+			},
+			"image_base64": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "Base64 encoded image content",
+				ConflictsWith: []string{"image", "image_url"},
+			},
+			"image_url": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "URL the image is downloaded from during plan and apply",
+				ConflictsWith: []string{"image", "image_base64"},
+				ValidateFunc:  validation.IsURLWithHTTPorHTTPS,
+			},
+			"image_resize": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Scale PNG, JPEG or GIF images into the provider's icon_requirements and preview_image_requirements instead of rejecting them",
+			},
+			"image_convert_to_png": {
+				Type:     schema.TypeBool,
 				Optional: true,
 			},
 			"image_hash": {
-				Type:     schema.TypeString,
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "SHA-256 of the image as it is uploaded, computed during plan. Empty when there is no icon.",
+				Deprecated:  "image_hash is computed by the provider and a configured value is ignored, remove it from the configuration",
+			},
+			"icon_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "URL of the published icon that image_hash was computed from, without its query string",
+			},
+			"preview_image": {
+				Type:     schema.TypeList,
 				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"image": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Path to the image file",
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"preview_images_hash": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "SHA-256 of the preview images as they are uploaded and their descriptions, computed during plan. Empty when there are none.",
+			},
+			"preview_image_urls": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "URLs of the published preview images that preview_images_hash was computed from",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
 			},
 			"app_tile_id": {
 				Type:     schema.TypeString,
 				Required: true,
 			},
 			"version": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateSemver,
 			},
 			"auto_version": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Compute the next version during plan when version isn't set",
+			},
+			"auto_version_strategy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      VERSION_BUMP_PATCH,
+				Description:  "Bump used for changed attributes that no auto_version_rule matches",
+				ValidateFunc: validation.StringInSlice(versionBumps, false),
+			},
+			"auto_version_rule": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"attributes": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice(appTilePublishKeys, false),
+							},
+						},
+						"bump": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(versionBumps, false),
+						},
+					},
+				},
+			},
+			"include_publish_reviews": {
 				Type:     schema.TypeBool,
 				Optional: true,
 			},
+			"publish_reviews": publishReviewsSchema(),
+			"publish_review": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Publish through the marketplace review process instead of publishing directly",
+			},
+			"pending_review_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"retain_versions": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "Delete published versions older than the newest N after each publish, unless they are installed",
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"tags": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"allowed_new_tags": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Tags that may be used even though they aren't in the tag catalog when strict_tags is enabled",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"price": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Paid prices can only be published while the connect account is ENABLED",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"amount": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							Description:  "Amount in USD pennies",
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"interval": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(PaymentIntervalFree),
+								string(PaymentIntervalOnce),
+								string(PaymentIntervalMonthly),
+								string(PaymentIntervalYearly),
+							}, false),
+						},
+					},
+				},
+			},
+			"deletion_protection": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Refuse to delete the module while set",
+			},
+			"check_usage_before_delete": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Refuse to delete the module while your organization has installed it or you have purchased it. Installs and purchases of other customers can't be seen, so this doesn't protect them.",
+			},
+			"force_delete": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Delete the module even if check_usage_before_delete finds active installs or purchases",
+			},
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
 		},
-		Create: createAppTile,
-		Read:   readAppTile,
-		Update: updateAppTile,
-		Delete: deleteAppTile,
+		CustomizeDiff: customizeAppTileDiff,
+		Create:        createAppTile,
+		Read:          readAppTile,
+		Update:        updateAppTile,
+		Delete:        deleteAppTile,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -0,0 +1,149 @@
+package marketplace
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+func TestRefreshImageHash(t *testing.T) {
+	icon := []byte("published icon")
+	downloads := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		downloads++
+		w.Header().Set("Content-Type", "image/png")
+		w.Write(icon)
+	}))
+	defer server.Close()
+
+	sum := sha256.Sum256(icon)
+	expected := hex.EncodeToString(sum[:])
+
+	// An imported module only has its id, so the live icon is hashed instead of treated as new
+	d := schema.TestResourceDataRaw(t, appTileResource().Schema, map[string]interface{}{})
+	d.SetId("module")
+	app := &AppTileModule{IconV2: &AppTileModuleIconV2MarketplaceModuleImage{Url: server.URL + "/icon.png?X-Amz-Signature=a"}}
+	if err := refreshImageHash(d, app); err != nil {
+		t.Fatal(err)
+	}
+	if d.Get("image_hash") != expected || d.Get("icon_url") != server.URL+"/icon.png" || downloads != 1 {
+		t.Fatalf("unexpected image_hash %q and icon_url %q after %d downloads", d.Get("image_hash"), d.Get("icon_url"), downloads)
+	}
+
+	// A new presigned query string for the same icon isn't downloaded again
+	app.IconV2.Url = server.URL + "/icon.png?X-Amz-Signature=b"
+	if err := refreshImageHash(d, app); err != nil {
+		t.Fatal(err)
+	}
+	if downloads != 1 {
+		t.Errorf("expected the icon not to be downloaded again, got %d downloads", downloads)
+	}
+
+	app.IconV2 = nil
+	if err := refreshImageHash(d, app); err != nil {
+		t.Fatal(err)
+	}
+	if d.Get("image_hash") != "" || d.Get("icon_url") != "" {
+		t.Errorf("expected a removed icon to clear image_hash and icon_url, got %q and %q", d.Get("image_hash"), d.Get("icon_url"))
+	}
+}
+
+func TestAdoptAppTile(t *testing.T) {
+	notFound := &gqlerror.Error{Message: "Module not found", Extensions: map[string]interface{}{"code": "NOT_FOUND"}}
+	cases := []struct {
+		name        string
+		source      map[string]interface{}
+		draftTitle  string
+		deleteDraft *gqlerror.Error
+		adopted     bool
+		deleted     bool
+		fails       bool
+	}{
+		{"same app tile", map[string]interface{}{"__typename": "AppTile", "id": "app-tile"}, "", nil, true, false, false},
+		{"other app tile", map[string]interface{}{"__typename": "AppTile", "id": "other"}, "", nil, false, false, true},
+		{"not an app tile", map[string]interface{}{"__typename": "Consent"}, "", nil, false, false, true},
+		{"draft deleted", nil, "name", nil, false, true, false},
+		{"no draft", nil, "", nil, false, false, false},
+		{"draft of another configuration", nil, "other", nil, false, false, true},
+		{"draft not deleted", nil, "name", &gqlerror.Error{Message: "Forbidden", Extensions: map[string]interface{}{"code": "FORBIDDEN"}}, false, true, true},
+	}
+
+	for _, c := range cases {
+		deleted := false
+		client := newFakeGraphQLClient(t, func(operation string, variables map[string]interface{}) (interface{}, *gqlerror.Error) {
+			switch operation {
+			case "GetPublishedModule":
+				if c.source == nil {
+					return nil, notFound
+				}
+				return map[string]interface{}{"myModule": map[string]interface{}{"title": "name", "version": "1.0.0", "source": c.source}}, nil
+			case "GetDraftModule":
+				if c.draftTitle == "" {
+					return nil, notFound
+				}
+				return map[string]interface{}{"draftModule": map[string]interface{}{"title": c.draftTitle, "description": "description"}}, nil
+			case "DeleteDraftModule":
+				deleted = true
+				if c.deleteDraft != nil {
+					return nil, c.deleteDraft
+				}
+				return map[string]interface{}{"deleteDraftModule": map[string]interface{}{"id": variables["moduleId"]}}, nil
+			}
+			t.Errorf("%s: unexpected operation %s", c.name, operation)
+			return nil, &gqlerror.Error{Message: "unexpected operation"}
+		})
+
+		d := schema.TestResourceDataRaw(t, appTileResource().Schema, map[string]interface{}{"name": "name", "description": "description", "app_tile_id": "app-tile", "module_id": "module"})
+		app, err := adoptAppTile(d, client, "module")
+		if (err != nil) != c.fails {
+			t.Errorf("%s: unexpected error %v", c.name, err)
+		}
+		if (app != nil) != c.adopted {
+			t.Errorf("%s: expected adopted to be %t", c.name, c.adopted)
+		}
+		if deleted != c.deleted {
+			t.Errorf("%s: expected the draft to be deleted to be %t", c.name, c.deleted)
+		}
+	}
+}
+
+func TestReadAppTileDuringPendingReview(t *testing.T) {
+	for _, status := range []string{"NEW", "APPROVED"} {
+		client := newFakeGraphQLClient(t, func(operation string, variables map[string]interface{}) (interface{}, *gqlerror.Error) {
+			switch operation {
+			case "GetModulePublishReview":
+				return map[string]interface{}{"modulePublishReview": map[string]interface{}{"id": "review", "moduleId": "module", "moduleVersion": "1.0.1", "status": status}}, nil
+			case "GetPublishedModule":
+				return map[string]interface{}{"myModule": map[string]interface{}{
+					"title":   "old",
+					"version": "1.0.0",
+					"source":  map[string]interface{}{"__typename": "AppTile", "id": "app-tile"},
+				}}, nil
+			}
+			t.Errorf("%s: unexpected operation %s", status, operation)
+			return nil, &gqlerror.Error{Message: "unexpected operation"}
+		})
+
+		d := schema.TestResourceDataRaw(t, appTileResource().Schema, map[string]interface{}{
+			"name":        "new",
+			"version":     "1.0.1",
+			"app_tile_id": "app-tile",
+		})
+		d.SetId("module")
+		d.Set("pending_review_id", "review")
+		if err := readAppTile(d, client); err != nil {
+			t.Fatal(err)
+		}
+
+		expected := map[string]string{"NEW": "new 1.0.1 review", "APPROVED": "old 1.0.0 "}[status]
+		if got := fmt.Sprintf("%s %s %s", d.Get("name"), d.Get("version"), d.Get("pending_review_id")); got != expected {
+			t.Errorf("%s: expected %q, got %q", status, expected, got)
+		}
+	}
+}
@@ -0,0 +1,184 @@
+package marketplace
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// refreshInstall reads an install into the module_id, version, category and installed_on
+// attributes. It returns false when the install or its module no longer exists.
+func refreshInstall(d *schema.ResourceData, client *MarketplaceClient, installId string) (bool, error) {
+	install, err := client.getInstall(installId, int64(d.Get("installed_on").(int)), d.Get("organization").(bool))
+	if isNotFound(err) {
+		log.Printf("Install %s no longer exists, removing from state", installId)
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	switch module := install.Module.(type) {
+	case *InstallFieldsModuleMarketplaceModule:
+		d.Set("module_id", module.Id)
+		d.Set("version", module.Version)
+		d.Set("category", string(module.Category))
+	case *InstallFieldsModuleModuleDeletedMessage:
+		log.Printf("Module %s of install %s was deleted, removing from state", module.ModuleId, installId)
+		return false, nil
+	case *InstallFieldsModuleIncorrectScopeMessage:
+		return false, fmt.Errorf("install %s is out of scope: %s", installId, module.Message)
+	}
+
+	d.Set("installed_on", int(install.InstalledOn))
+	return true, nil
+}
+
+// installAndWait runs install and waits for the install it creates. Installs of the same module
+// version are serialized, because the new install is only recognizable as the one that wasn't
+// listed before.
+func installAndWait(client *MarketplaceClient, moduleId string, version string, org bool, timeout time.Duration, install func() error) (*InstallFields, error) {
+	unlock := client.installLocks.lock(fmt.Sprintf("%t/%s/%s", org, moduleId, version))
+	defer unlock()
+
+	before, err := client.recentInstalls(moduleId, version, org)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the installs of module %s: %w", moduleId, err)
+	}
+	if err := install(); err != nil {
+		return nil, err
+	}
+	return waitForInstall(client, moduleId, version, org, before, timeout)
+}
+
+func readInstall(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*MarketplaceClient)
+	exists, err := refreshInstall(d, client, d.Id())
+	if err != nil {
+		return err
+	}
+	if !exists {
+		d.SetId("")
+	}
+	return nil
+}
+
+func createInstall(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*MarketplaceClient)
+	params := moduleInstall{
+		ModuleId:      d.Get("module_id").(string),
+		Version:       d.Get("version").(string),
+		Project:       d.Get("project").(string),
+		SurveyVersion: d.Get("survey_version").(string),
+		Organization:  d.Get("organization").(bool),
+	}
+
+	var result *moduleInstallResult
+	install, err := installAndWait(client, params.ModuleId, params.Version, params.Organization, d.Timeout(schema.TimeoutCreate), func() error {
+		var err error
+		result, err = client.installModule(params)
+		if err != nil {
+			return fmt.Errorf("failed to install module %s: %w", params.ModuleId, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	d.SetId(install.Id)
+	d.Set("installed_on", int(install.InstalledOn))
+	d.Set("category", string(result.Category))
+	d.Set("app_tile_id", result.AppTileId)
+	d.Set("resource_id", result.ResourceId)
+	return readInstall(d, meta)
+}
+
+func deleteInstall(d *schema.ResourceData, meta interface{}) error {
+	// The marketplace has no uninstall mutation, so the install is only forgotten
+	log.Printf("Install %s of module %s cannot be uninstalled through the marketplace API, removing from state", d.Id(), d.Get("module_id").(string))
+	d.SetId("")
+	return nil
+}
+
+func importInstall(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), ":")
+	if len(parts) < 2 || len(parts) > 3 {
+		return nil, fmt.Errorf("expected import id in the form <install_id>:<installed_on>[:org], got %s", d.Id())
+	}
+
+	installedOn, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return nil, fmt.Errorf("invalid installed_on %s: %w", parts[1], err)
+	}
+
+	d.SetId(parts[0])
+	d.Set("installed_on", installedOn)
+	d.Set("organization", len(parts) == 3 && parts[2] == "org")
+	return []*schema.ResourceData{d}, nil
+}
+
+func installResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"module_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"project": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Project to install into, required by project scoped categories such as surveys and layouts",
+			},
+			"survey_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"organization": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				ForceNew:    true,
+				Description: "Look the module and install up in the organization scope instead of the caller's",
+			},
+			"category": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"installed_on": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"app_tile_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"resource_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Id of the resource created by domain and process ontology installs",
+			},
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Create: createInstall,
+		Read:   readInstall,
+		Delete: deleteInstall,
+		Importer: &schema.ResourceImporter{
+			State: importInstall,
+		},
+	}
+}
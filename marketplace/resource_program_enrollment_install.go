@@ -0,0 +1,159 @@
+package marketplace
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func readProgramEnrollmentInstall(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*MarketplaceClient)
+	exists, err := refreshInstall(d, client, d.Get("install_id").(string))
+	if err != nil {
+		return err
+	}
+	if !exists {
+		d.SetId("")
+	}
+	return nil
+}
+
+func createProgramEnrollmentInstall(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*MarketplaceClient)
+	params := moduleInstall{
+		ModuleId:                d.Get("module_id").(string),
+		Version:                 d.Get("version").(string),
+		EnrollmentScheduledTime: d.Get("enrollment_scheduled_time").(string),
+		Organization:            d.Get("organization").(bool),
+		ExpectedCategory:        ModuleCategoryProgramEnrollment,
+	}
+
+	install, err := installAndWait(client, params.ModuleId, params.Version, params.Organization, d.Timeout(schema.TimeoutCreate), func() error {
+		if _, err := client.installModule(params); err != nil {
+			return fmt.Errorf("failed to install program enrollment %s: %w", params.ModuleId, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	d.SetId(install.Id)
+	d.Set("install_id", install.Id)
+	d.Set("installed_on", int(install.InstalledOn))
+	return readProgramEnrollmentInstall(d, meta)
+}
+
+func deleteProgramEnrollmentInstall(d *schema.ResourceData, meta interface{}) error {
+	return deleteInstall(d, meta)
+}
+
+// programEnrollmentInstallCreated reports whether the plan installs the module, either because the
+// resource is new or because a change replaces it
+func programEnrollmentInstallCreated(d *schema.ResourceDiff) bool {
+	if d.Id() == "" {
+		return true
+	}
+	for key, attribute := range programEnrollmentInstallResource().Schema {
+		if attribute.ForceNew && d.HasChange(key) {
+			return true
+		}
+	}
+	return false
+}
+
+// customizeProgramEnrollmentInstallDiff checks that the scheduled time is still in the future
+// whenever the plan installs, including a replacement that reuses an earlier scheduled time
+func customizeProgramEnrollmentInstallDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !programEnrollmentInstallCreated(d) || !d.NewValueKnown("enrollment_scheduled_time") {
+		return nil
+	}
+	scheduled := d.Get("enrollment_scheduled_time").(string)
+	if scheduled == "" {
+		return nil
+	}
+
+	scheduledTime, err := time.Parse(time.RFC3339, scheduled)
+	if err != nil {
+		return err
+	}
+	if !scheduledTime.After(time.Now()) {
+		return fmt.Errorf("enrollment_scheduled_time %s is not in the future", scheduled)
+	}
+	return nil
+}
+
+// normalizeScheduledTime stores scheduled times in UTC so time zone offsets don't cause diffs
+func normalizeScheduledTime(value interface{}) string {
+	scheduledTime, err := time.Parse(time.RFC3339, value.(string))
+	if err != nil {
+		return value.(string)
+	}
+	return scheduledTime.UTC().Format(time.RFC3339)
+}
+
+func suppressEquivalentTime(k, old, new string, d *schema.ResourceData) bool {
+	oldTime, err := time.Parse(time.RFC3339, old)
+	if err != nil {
+		return false
+	}
+	newTime, err := time.Parse(time.RFC3339, new)
+	if err != nil {
+		return false
+	}
+	return oldTime.Equal(newTime)
+}
+
+func programEnrollmentInstallResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"module_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"enrollment_scheduled_time": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				Description:      "RFC3339 time the cohort enrollment starts, must be in the future whenever the module is installed",
+				ValidateFunc:     validation.IsRFC3339Time,
+				StateFunc:        normalizeScheduledTime,
+				DiffSuppressFunc: suppressEquivalentTime,
+			},
+			"organization": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				ForceNew: true,
+			},
+			"category": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"install_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"installed_on": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+		},
+		CustomizeDiff: customizeProgramEnrollmentInstallDiff,
+		Create:        createProgramEnrollmentInstall,
+		Read:          readProgramEnrollmentInstall,
+		Delete:        deleteProgramEnrollmentInstall,
+	}
+}
@@ -0,0 +1,161 @@
+package marketplace
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	REVIEW_DECISION_APPROVE = "APPROVE"
+	REVIEW_DECISION_DENY    = "DENY"
+)
+
+func readPublishReviewDecision(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*MarketplaceClient)
+	moduleId := d.Get("module_id").(string)
+
+	reviews, err := client.listPublishReviews(moduleId)
+	if isNotFound(err) {
+		log.Printf("Module %s no longer exists, removing review decision from state", moduleId)
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	var latest *PublishReviewFields
+	for i := range reviews {
+		if latest == nil || reviews[i].Created > latest.Created {
+			latest = &reviews[i]
+		}
+	}
+	if latest != nil {
+		d.Set("status", string(latest.Status))
+	}
+	return nil
+}
+
+func createPublishReviewDecision(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*MarketplaceClient).gqlClient
+	moduleId := d.Get("module_id").(string)
+
+	if d.Get("assign").(bool) {
+		assignRes, err := AssignDraftModuleForReview(context.Background(), client, moduleId)
+		if err != nil {
+			return fmt.Errorf("failed to assign module %s for review: %w", moduleId, err)
+		}
+		d.Set("assigned_reviewer", assignRes.AssignDraftModuleForReview.AssignedReviewer)
+	}
+
+	switch d.Get("decision").(string) {
+	case REVIEW_DECISION_APPROVE:
+		entitlements := []Entitlement{}
+		for _, entitlement := range d.Get("entitlements").(*schema.Set).List() {
+			entitlements = append(entitlements, Entitlement(entitlement.(string)))
+		}
+		res, err := ApproveModulePublish(context.Background(), client, ApproveModulePublishInput{
+			Entitlements: entitlements,
+			ModuleId:     moduleId,
+			Notes:        d.Get("notes").(string),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to approve module %s: %w", moduleId, err)
+		}
+		d.Set("published_version", res.ApproveModulePublish.Version.Version)
+	case REVIEW_DECISION_DENY:
+		if _, err := DenyModulePublish(context.Background(), client, DenyModulePublishInput{
+			ModuleId: moduleId,
+			Notes:    d.Get("notes").(string),
+		}); err != nil {
+			return fmt.Errorf("failed to deny module %s: %w", moduleId, err)
+		}
+	}
+
+	d.SetId(moduleId)
+	return readPublishReviewDecision(d, meta)
+}
+
+func deletePublishReviewDecision(d *schema.ResourceData, meta interface{}) error {
+	// Review decisions are final, so destroying the resource only forgets it
+	log.Printf("Review decision for module %s cannot be reverted, removing from state", d.Id())
+	d.SetId("")
+	return nil
+}
+
+func customizePublishReviewDecisionDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	decision := d.Get("decision").(string)
+	if decision == REVIEW_DECISION_DENY && d.NewValueKnown("notes") && d.Get("notes").(string) == "" {
+		return errors.New("notes are required when denying a publish")
+	}
+	if decision == REVIEW_DECISION_DENY && d.Get("entitlements").(*schema.Set).Len() > 0 {
+		return errors.New("entitlements can only be granted when approving a publish")
+	}
+	return nil
+}
+
+func publishReviewDecisionResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"module_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"decision": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					REVIEW_DECISION_APPROVE,
+					REVIEW_DECISION_DENY,
+				}, false),
+			},
+			"notes": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"entitlements": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(EntitlementLifeascent),
+						string(EntitlementLifeplus),
+					}, false),
+				},
+			},
+			"assign": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				ForceNew:    true,
+				Description: "Assign the draft to the caller before deciding",
+			},
+			"assigned_reviewer": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"published_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+		CustomizeDiff: customizePublishReviewDecisionDiff,
+		Create:        createPublishReviewDecision,
+		Read:          readPublishReviewDecision,
+		Delete:        deletePublishReviewDecision,
+	}
+}
@@ -0,0 +1,152 @@
+package marketplace
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func readReviewReply(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*MarketplaceClient)
+	moduleId := d.Get("module_id").(string)
+	ratingId := d.Get("rating_id").(string)
+
+	replies, err := client.listReviewReplies(moduleId, ratingId)
+	if err != nil {
+		return err
+	}
+
+	for _, reply := range replies {
+		if reply.Id == d.Id() && !reply.Deleted {
+			d.Set("comment", reply.Message)
+			return nil
+		}
+	}
+
+	log.Printf("Reply %s to review %s no longer exists, removing from state", d.Id(), ratingId)
+	d.SetId("")
+	return nil
+}
+
+// listReviewReplyIds returns the ids of the replies to a review
+func listReviewReplyIds(client *MarketplaceClient, moduleId string, ratingId string) (map[string]bool, error) {
+	replies, err := client.listReviewReplies(moduleId, ratingId)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := map[string]bool{}
+	for _, reply := range replies {
+		ids[reply.Id] = true
+	}
+	return ids, nil
+}
+
+func createReviewReply(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*MarketplaceClient)
+	moduleId := d.Get("module_id").(string)
+	ratingId := d.Get("rating_id").(string)
+	comment := d.Get("comment").(string)
+
+	// The created reply is the one that wasn't there before, since canned replies can repeat the
+	// text of an older one. Replies to the same review are created one at a time to keep it that way.
+	unlock := client.replyLocks.lock(moduleId + "/" + ratingId)
+	defer unlock()
+
+	before, err := listReviewReplyIds(client, moduleId, ratingId)
+	if err != nil {
+		return fmt.Errorf("failed to list the replies to review %s: %w", ratingId, err)
+	}
+
+	res, err := CreateMarketplaceReviewReply(context.Background(), client.gqlClient, CreateMarketplaceReviewReplyInput{
+		Comment:  comment,
+		ModuleId: moduleId,
+		RatingId: ratingId,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to reply to review %s: %w", ratingId, err)
+	}
+
+	created := []string{}
+	for _, reply := range reviewReplies(res.CreateMarketplaceReviewReply.ReviewFields) {
+		if !before[reply.Id] && !reply.Deleted && reply.Message == comment {
+			created = append(created, reply.Id)
+		}
+	}
+	if len(created) != 1 {
+		return fmt.Errorf("expected one new reply to review %s, found %d", ratingId, len(created))
+	}
+	d.SetId(created[0])
+	return readReviewReply(d, meta)
+}
+
+func updateReviewReply(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*MarketplaceClient).gqlClient
+	if _, err := UpdateMarketplaceReviewReply(context.Background(), client, UpdateMarketplaceReviewReplyInput_v2{
+		Comment:  d.Get("comment").(string),
+		ModuleId: d.Get("module_id").(string),
+		RatingId: d.Get("rating_id").(string),
+		ReplyId:  d.Id(),
+	}); err != nil {
+		return fmt.Errorf("failed to update review reply %s: %w", d.Id(), err)
+	}
+	return readReviewReply(d, meta)
+}
+
+func deleteReviewReply(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*MarketplaceClient).gqlClient
+	if _, err := RemoveMarketplaceReviewReply(context.Background(), client, RemoveMarketplaceReviewReplyInput_v2{
+		ModuleId: d.Get("module_id").(string),
+		RatingId: d.Get("rating_id").(string),
+		ReplyId:  d.Id(),
+	}); err != nil {
+		return fmt.Errorf("failed to remove review reply %s: %w", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func importReviewReply(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), ":")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("expected import id in the form <module_id>:<rating_id>:<reply_id>, got %s", d.Id())
+	}
+
+	d.Set("module_id", parts[0])
+	d.Set("rating_id", parts[1])
+	d.SetId(parts[2])
+	return []*schema.ResourceData{d}, nil
+}
+
+func reviewReplyResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"module_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"rating_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Id of the review being replied to",
+			},
+			"comment": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+		Create: createReviewReply,
+		Read:   readReviewReply,
+		Update: updateReviewReply,
+		Delete: deleteReviewReply,
+		Importer: &schema.ResourceImporter{
+			State: importReviewReply,
+		},
+	}
+}
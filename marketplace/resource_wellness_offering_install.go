@@ -0,0 +1,229 @@
+package marketplace
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func wellnessOfferingInstallParams(d *schema.ResourceData) wellnessOfferingInstall {
+	return wellnessOfferingInstall{
+		ModuleId:         d.Get("module_id").(string),
+		Version:          d.Get("version").(string),
+		Configuration:    d.Get("configuration").(string),
+		Enabled:          d.Get("enabled").(bool),
+		EngagementTarget: d.Get("engagement_target").(int),
+		SubsidyAmount:    d.Get("subsidy_amount").(int),
+		SubsidyPeriod:    Period(d.Get("subsidy_period").(string)),
+	}
+}
+
+func applyWellnessOfferingInstall(d *schema.ResourceData, client *MarketplaceClient, timeout string) (string, error) {
+	params := wellnessOfferingInstallParams(d)
+	id := ""
+	install, err := installAndWait(client, params.ModuleId, params.Version, d.Get("organization").(bool), d.Timeout(timeout), func() error {
+		var err error
+		id, err = client.installWellnessOffering(params)
+		if err != nil {
+			return fmt.Errorf("failed to install wellness offering %s: %w", params.ModuleId, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	d.Set("install_id", install.Id)
+	d.Set("installed_on", int(install.InstalledOn))
+	return id, nil
+}
+
+func readWellnessOfferingInstall(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*MarketplaceClient)
+	exists, err := refreshInstall(d, client, d.Get("install_id").(string))
+	if err != nil {
+		return err
+	}
+	if !exists {
+		d.SetId("")
+	}
+	return nil
+}
+
+func createWellnessOfferingInstall(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*MarketplaceClient)
+	id, err := applyWellnessOfferingInstall(d, client, schema.TimeoutCreate)
+	if err != nil {
+		return err
+	}
+	d.SetId(id)
+	return readWellnessOfferingInstall(d, meta)
+}
+
+func updateWellnessOfferingInstall(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*MarketplaceClient)
+	// Installing again replaces the configuration of the existing offering with a new install
+	if _, err := applyWellnessOfferingInstall(d, client, schema.TimeoutUpdate); err != nil {
+		return err
+	}
+	return readWellnessOfferingInstall(d, meta)
+}
+
+func deleteWellnessOfferingInstall(d *schema.ResourceData, meta interface{}) error {
+	return deleteInstall(d, meta)
+}
+
+// wellnessOfferingInstallKeys are the attributes that install the offering again when they change
+var wellnessOfferingInstallKeys = []string{
+	"version",
+	"configuration",
+	"enabled",
+	"engagement_target",
+	"subsidy_amount",
+	"subsidy_period",
+}
+
+func customizeWellnessOfferingInstallDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" && d.HasChanges(wellnessOfferingInstallKeys...) {
+		// Installing again creates a new install
+		if err := d.SetNewComputed("install_id"); err != nil {
+			return err
+		}
+		if err := d.SetNewComputed("installed_on"); err != nil {
+			return err
+		}
+	}
+	return checkWellnessOfferingConfiguration(d, meta)
+}
+
+// checkWellnessOfferingConfiguration validates the configuration against the offering's configuration schema
+func checkWellnessOfferingConfiguration(d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("configuration") || !d.NewValueKnown("module_id") || !d.NewValueKnown("version") {
+		return nil
+	}
+	if d.Id() != "" && !d.HasChange("configuration") && !d.HasChange("version") {
+		return nil
+	}
+
+	var configuration interface{}
+	if err := json.Unmarshal([]byte(d.Get("configuration").(string)), &configuration); err != nil {
+		return fmt.Errorf("configuration is not valid JSON: %w", err)
+	}
+
+	client := meta.(*MarketplaceClient)
+	moduleId := d.Get("module_id").(string)
+	rawSchema, err := client.getWellnessOfferingConfigurationSchema(moduleId, d.Get("version").(string))
+	if err != nil {
+		return fmt.Errorf("failed to load the configuration schema of %s: %w", moduleId, err)
+	}
+
+	var configurationSchema map[string]interface{}
+	if err := json.Unmarshal([]byte(rawSchema), &configurationSchema); err != nil {
+		return fmt.Errorf("configuration schema of %s is not a JSON object: %w", moduleId, err)
+	}
+
+	if problems := validateJSONSchema(configurationSchema, configuration, ""); len(problems) > 0 {
+		return fmt.Errorf("configuration does not match the configuration schema of %s:\n  %s", moduleId, strings.Join(problems, "\n  "))
+	}
+	return nil
+}
+
+func normalizeConfiguration(value interface{}) string {
+	normalized, err := normalizeJSON(value.(string))
+	if err != nil {
+		return value.(string)
+	}
+	return normalized
+}
+
+func suppressEquivalentJSON(k, old, new string, d *schema.ResourceData) bool {
+	var oldValue, newValue interface{}
+	if json.Unmarshal([]byte(old), &oldValue) != nil || json.Unmarshal([]byte(new), &newValue) != nil {
+		return false
+	}
+	return reflect.DeepEqual(oldValue, newValue)
+}
+
+func wellnessOfferingInstallResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"module_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"configuration": {
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "Offering configuration as JSON, validated against the offering's configuration schema",
+				ValidateFunc:     validation.StringIsJSON,
+				StateFunc:        normalizeConfiguration,
+				DiffSuppressFunc: suppressEquivalentJSON,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"engagement_target": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				Description:  "Target engagement percentage for employee redemption",
+				ValidateFunc: validation.IntBetween(0, 100),
+			},
+			"subsidy_amount": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				Description:  "Amount the employer subsidizes, in USD pennies",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"subsidy_period": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(PeriodMonthly),
+					string(PeriodQuarterly),
+					string(PeriodBiannually),
+					string(PeriodAnnually),
+				}, false),
+			},
+			"organization": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				ForceNew: true,
+			},
+			"category": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"install_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"installed_on": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+		},
+		CustomizeDiff: customizeWellnessOfferingInstallDiff,
+		Create:        createWellnessOfferingInstall,
+		Read:          readWellnessOfferingInstall,
+		Update:        updateWellnessOfferingInstall,
+		Delete:        deleteWellnessOfferingInstall,
+	}
+}
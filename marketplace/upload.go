@@ -0,0 +1,167 @@
+package marketplace
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"log"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"sort"
+	"strings"
+	"time"
+)
+
+const UPLOAD_ATTEMPTS = 4
+
+// uploadBackoff is the delay before the first retry, doubling on each attempt after that
+var uploadBackoff = time.Second
+
+var uploadClient = &http.Client{Timeout: 5 * time.Minute}
+
+// uploadError is a failed presigned POST, with the details S3 reports in its XML error body
+type uploadError struct {
+	StatusCode int
+	Code       string
+	Message    string
+	RequestId  string
+}
+
+func (err *uploadError) Error() string {
+	if err.Code == "" {
+		return fmt.Sprintf("image upload failed with HTTP %d", err.StatusCode)
+	}
+	message := fmt.Sprintf("image upload failed with HTTP %d %s: %s", err.StatusCode, err.Code, err.Message)
+	if err.RequestId != "" {
+		message += fmt.Sprintf(" (request id %s)", err.RequestId)
+	}
+	return message
+}
+
+func (err *uploadError) retryable() bool {
+	switch err.Code {
+	case "SlowDown", "RequestTimeout", "InternalError", "ServiceUnavailable":
+		return true
+	}
+	return err.StatusCode == http.StatusTooManyRequests || err.StatusCode >= 500
+}
+
+func parseUploadError(resp *http.Response) *uploadError {
+	result := &uploadError{StatusCode: resp.StatusCode}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 64*1024))
+	if err != nil {
+		return result
+	}
+
+	var s3Error struct {
+		Code      string `xml:"Code"`
+		Message   string `xml:"Message"`
+		RequestId string `xml:"RequestId"`
+	}
+	if xml.Unmarshal(body, &s3Error) == nil && s3Error.Code != "" {
+		result.Code = s3Error.Code
+		result.Message = s3Error.Message
+		result.RequestId = s3Error.RequestId
+	} else if text := strings.TrimSpace(string(body)); text != "" {
+		result.Code = http.StatusText(resp.StatusCode)
+		result.Message = text
+	}
+	return result
+}
+
+// quoteEscaper escapes a quoted header parameter the same way mime/multipart does
+var quoteEscaper = strings.NewReplacer("\\", "\\\\", `"`, "\\\"")
+
+// multipartBody streams the form fields followed by the file, with a length known up front so
+// that the request isn't sent chunked, which S3 rejects for presigned POSTs. Only the fields and
+// the boundaries are copied, the content is read from where it is.
+func multipartBody(fileName string, contentType string, content io.ReadSeeker, fields map[string]string) (io.Reader, string, int64, error) {
+	size, err := content.Seek(0, io.SeekEnd)
+	if err != nil {
+		return nil, "", 0, err
+	}
+	if _, err := content.Seek(0, io.SeekStart); err != nil {
+		return nil, "", 0, err
+	}
+
+	head := &bytes.Buffer{}
+	writer := multipart.NewWriter(head)
+
+	// S3 ignores any field after the file, so the fields go first in a stable order
+	keys := make([]string, 0, len(fields))
+	for key := range fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if err := writer.WriteField(key, fields[key]); err != nil {
+			return nil, "", 0, err
+		}
+	}
+
+	header := textproto.MIMEHeader{}
+	header.Set("Content-Disposition", fmt.Sprintf(`form-data; name="file"; filename="%s"`, quoteEscaper.Replace(fileName)))
+	header.Set("Content-Type", contentType)
+	if _, err := writer.CreatePart(header); err != nil {
+		return nil, "", 0, err
+	}
+
+	headBytes := head.Len()
+	if err := writer.Close(); err != nil {
+		return nil, "", 0, err
+	}
+	tail := append([]byte{}, head.Bytes()[headBytes:]...)
+	head.Truncate(headBytes)
+
+	length := int64(head.Len()) + size + int64(len(tail))
+	return io.MultiReader(head, content, bytes.NewReader(tail)), writer.FormDataContentType(), length, nil
+}
+
+func postImageOnce(url string, fileName string, contentType string, content io.ReadSeeker, fields map[string]string) error {
+	body, formContentType, length, err := multipartBody(fileName, contentType, content, fields)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest("POST", url, body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", formContentType)
+	req.ContentLength = length
+
+	resp, err := uploadClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return parseUploadError(resp)
+	}
+	io.Copy(io.Discard, resp.Body)
+	return nil
+}
+
+// postImageToUrl uploads an image to a presigned POST url, retrying transient failures with backoff
+func postImageToUrl(url string, fileName string, contentType string, content io.ReadSeeker, fields map[string]string) error {
+	delay := uploadBackoff
+	var err error
+	for attempt := 1; attempt <= UPLOAD_ATTEMPTS; attempt++ {
+		err = postImageOnce(url, fileName, contentType, content, fields)
+		if err == nil {
+			return nil
+		}
+		if uploadErr, ok := err.(*uploadError); ok && !uploadErr.retryable() {
+			return err
+		}
+		if attempt < UPLOAD_ATTEMPTS {
+			log.Printf("Image upload attempt %d failed, trying again in %s: %s", attempt, delay, err)
+			time.Sleep(delay)
+			delay *= 2
+		}
+	}
+	return fmt.Errorf("image upload failed after %d attempts: %w", UPLOAD_ATTEMPTS, err)
+}
@@ -0,0 +1,109 @@
+package marketplace
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// withoutUploadBackoff retries uploads immediately for the rest of the test
+func withoutUploadBackoff(t *testing.T) {
+	backoff := uploadBackoff
+	uploadBackoff = 0
+	t.Cleanup(func() { uploadBackoff = backoff })
+}
+
+func TestPostImageToUrl(t *testing.T) {
+	withoutUploadBackoff(t)
+	content := []byte("image content")
+	attempts := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if r.ContentLength <= 0 {
+			t.Errorf("expected a content length, got %d", r.ContentLength)
+		}
+		if err := r.ParseMultipartForm(1024 * 1024); err != nil {
+			t.Error(err)
+			return
+		}
+		if r.FormValue("key") != "uploads/icon.png" {
+			t.Errorf("unexpected key field %q", r.FormValue("key"))
+		}
+		file, header, err := r.FormFile("file")
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		uploaded, _ := io.ReadAll(file)
+		if !bytes.Equal(uploaded, content) || header.Filename != "icon.png" || header.Header.Get("Content-Type") != "image/png" {
+			t.Errorf("unexpected file %s (%s): %q", header.Filename, header.Header.Get("Content-Type"), uploaded)
+		}
+
+		if attempts == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			w.Write([]byte(`<Error><Code>SlowDown</Code><Message>Reduce your request rate.</Message></Error>`))
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	err := postImageToUrl(server.URL, "icon.png", "image/png", bytes.NewReader(content), map[string]string{"key": "uploads/icon.png"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if attempts != 2 {
+		t.Errorf("expected a retry after SlowDown, got %d attempts", attempts)
+	}
+}
+
+func TestPostImageToUrlForbidden(t *testing.T) {
+	withoutUploadBackoff(t)
+	attempts := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusForbidden)
+		w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?>
+<Error><Code>AccessDenied</Code><Message>Invalid according to Policy: Policy expired.</Message><RequestId>ABC123</RequestId></Error>`))
+	}))
+	defer server.Close()
+
+	err := postImageToUrl(server.URL, "icon.png", "image/png", bytes.NewReader([]byte("x")), nil)
+	if err == nil {
+		t.Fatal("expected a 403 to fail the upload")
+	}
+	if attempts != 1 {
+		t.Errorf("expected AccessDenied not to be retried, got %d attempts", attempts)
+	}
+	for _, expected := range []string{"403", "AccessDenied", "Policy expired", "ABC123"} {
+		if !strings.Contains(err.Error(), expected) {
+			t.Errorf("expected %q in %q", expected, err)
+		}
+	}
+}
+
+func TestMultipartBodyEscapesFileName(t *testing.T) {
+	fileName := `my "icon" \\ logo.png`
+	body, contentType, _, err := multipartBody(fileName, "image/png", bytes.NewReader([]byte("x")), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req := httptest.NewRequest("POST", "/", body)
+	req.Header.Set("Content-Type", contentType)
+	if err := req.ParseMultipartForm(1024); err != nil {
+		t.Fatal(err)
+	}
+	_, header, err := req.FormFile("file")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if header.Filename != fileName || header.Header.Get("Content-Type") != "image/png" {
+		t.Errorf("expected %q (image/png), got %q (%s)", fileName, header.Filename, header.Header.Get("Content-Type"))
+	}
+}
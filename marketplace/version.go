@@ -0,0 +1,107 @@
+package marketplace
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/coreos/go-semver/semver"
+)
+
+const (
+	VERSION_BUMP_PRERELEASE = "prerelease"
+	VERSION_BUMP_PATCH      = "patch"
+	VERSION_BUMP_MINOR      = "minor"
+	VERSION_BUMP_MAJOR      = "major"
+)
+
+// versionBumps is ordered from the smallest to the largest bump
+var versionBumps = []string{
+	VERSION_BUMP_PRERELEASE,
+	VERSION_BUMP_PATCH,
+	VERSION_BUMP_MINOR,
+	VERSION_BUMP_MAJOR,
+}
+
+func versionBumpRank(bump string) int {
+	for rank, candidate := range versionBumps {
+		if candidate == bump {
+			return rank
+		}
+	}
+	return -1
+}
+
+// largerVersionBump returns whichever bump changes the version the most
+func largerVersionBump(a, b string) string {
+	if versionBumpRank(b) > versionBumpRank(a) {
+		return b
+	}
+	return a
+}
+
+// bumpPreRelease increments the last numeric pre-release identifier, starting a new
+// pre-release of the next patch when the version has none: 1.2.3 -> 1.2.4-0 -> 1.2.4-1
+func bumpPreRelease(version *semver.Version) {
+	if version.PreRelease == "" {
+		version.BumpPatch()
+		version.PreRelease = "0"
+		return
+	}
+
+	identifiers := version.PreRelease.Slice()
+	last := len(identifiers) - 1
+	if number, err := strconv.Atoi(identifiers[last]); err == nil {
+		identifiers[last] = strconv.Itoa(number + 1)
+	} else {
+		identifiers = append(identifiers, "0")
+	}
+	version.PreRelease = semver.PreRelease(strings.Join(identifiers, "."))
+	version.Metadata = ""
+}
+
+// releasePreRelease turns a pre-release into the release it precedes when that release is already
+// the requested bump, so a patch bump of 1.2.4-0 gives 1.2.4 and a minor bump of 1.3.0-0 gives 1.3.0
+func releasePreRelease(version *semver.Version, bumped bool) bool {
+	if version.PreRelease == "" || !bumped {
+		return false
+	}
+	version.PreRelease = ""
+	version.Metadata = ""
+	return true
+}
+
+func validateSemver(value interface{}, key string) ([]string, []error) {
+	if _, err := semver.NewVersion(value.(string)); err != nil {
+		return nil, []error{fmt.Errorf("%s must be a semantic version such as 1.2.3: %w", key, err)}
+	}
+	return nil, nil
+}
+
+// bumpVersion returns the version after the given bump
+func bumpVersion(current string, bump string) (string, error) {
+	version, err := semver.NewVersion(current)
+	if err != nil {
+		return "", fmt.Errorf("cannot bump version %q: %w", current, err)
+	}
+
+	switch bump {
+	case VERSION_BUMP_PRERELEASE:
+		bumpPreRelease(version)
+	case VERSION_BUMP_PATCH:
+		if !releasePreRelease(version, true) {
+			version.BumpPatch()
+		}
+	case VERSION_BUMP_MINOR:
+		if !releasePreRelease(version, version.Patch == 0) {
+			version.BumpMinor()
+		}
+	case VERSION_BUMP_MAJOR:
+		if !releasePreRelease(version, version.Minor == 0 && version.Patch == 0) {
+			version.BumpMajor()
+		}
+	default:
+		return "", fmt.Errorf("unknown version bump %q", bump)
+	}
+	return version.String(), nil
+}
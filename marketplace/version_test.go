@@ -0,0 +1,62 @@
+package marketplace
+
+import "testing"
+
+func TestBumpVersion(t *testing.T) {
+	cases := []struct {
+		current  string
+		bump     string
+		expected string
+	}{
+		{"1.2.3", VERSION_BUMP_PATCH, "1.2.4"},
+		{"1.2.3", VERSION_BUMP_MINOR, "1.3.0"},
+		{"1.2.3", VERSION_BUMP_MAJOR, "2.0.0"},
+		{"1.2.3", VERSION_BUMP_PRERELEASE, "1.2.4-0"},
+		{"1.2.4-0", VERSION_BUMP_PRERELEASE, "1.2.4-1"},
+		{"1.2.4-beta.9", VERSION_BUMP_PRERELEASE, "1.2.4-beta.10"},
+		{"1.2.4-beta", VERSION_BUMP_PRERELEASE, "1.2.4-beta.0"},
+		{"1.2.4-beta.1", VERSION_BUMP_PATCH, "1.2.4"},
+		{"1.2.4-beta.1", VERSION_BUMP_MINOR, "1.3.0"},
+		{"1.3.0-beta.1", VERSION_BUMP_MINOR, "1.3.0"},
+		{"1.3.0-beta.1", VERSION_BUMP_MAJOR, "2.0.0"},
+		{"2.0.0-rc.1", VERSION_BUMP_MAJOR, "2.0.0"},
+		{"1.2.3+build.5", VERSION_BUMP_PATCH, "1.2.4"},
+	}
+
+	for _, c := range cases {
+		next, err := bumpVersion(c.current, c.bump)
+		if err != nil {
+			t.Errorf("%s %s: %s", c.current, c.bump, err)
+			continue
+		}
+		if next != c.expected {
+			t.Errorf("%s %s: got %s, expected %s", c.current, c.bump, next, c.expected)
+		}
+	}
+
+	if _, err := bumpVersion("not a version", VERSION_BUMP_PATCH); err == nil {
+		t.Error("expected an invalid version to fail")
+	}
+}
+
+func TestLargerVersionBump(t *testing.T) {
+	if bump := largerVersionBump("", VERSION_BUMP_PRERELEASE); bump != VERSION_BUMP_PRERELEASE {
+		t.Errorf("expected prerelease, got %s", bump)
+	}
+	if bump := largerVersionBump(VERSION_BUMP_MINOR, VERSION_BUMP_PATCH); bump != VERSION_BUMP_MINOR {
+		t.Errorf("expected minor, got %s", bump)
+	}
+}
+
+func TestValidateSemver(t *testing.T) {
+	for _, version := range []string{"0.0.0", "1.2.3-beta.1", "1.2.3+build.5"} {
+		if _, errs := validateSemver(version, "version"); len(errs) > 0 {
+			t.Errorf("%s: %s", version, errs[0])
+		}
+	}
+	for _, version := range []string{"", "1.2", "v1.2.3", "latest"} {
+		if _, errs := validateSemver(version, "version"); len(errs) == 0 {
+			t.Errorf("expected %q to be rejected", version)
+		}
+	}
+}
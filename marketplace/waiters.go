@@ -0,0 +1,98 @@
+package marketplace
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	WAIT_STATE_MISSING = "missing"
+	WAIT_STATE_PRESENT = "present"
+)
+
+// waitMinTimeout is the shortest delay between polls
+var waitMinTimeout = time.Second
+
+// waitForState polls refresh with exponential backoff, from waitMinTimeout up to ten seconds, until
+// it reports the target state or the timeout runs out
+func waitForState(description string, pending string, target string, timeout time.Duration, refresh resource.StateRefreshFunc) (interface{}, error) {
+	conf := &resource.StateChangeConf{
+		Pending:    []string{pending},
+		Target:     []string{target},
+		Refresh:    refresh,
+		Timeout:    timeout,
+		MinTimeout: waitMinTimeout,
+	}
+	result, err := conf.WaitForState()
+	if err != nil {
+		return nil, fmt.Errorf("waiting for %s: %w", description, err)
+	}
+	return result, nil
+}
+
+// waitForAppTile waits until the module is readable at the given version, or at any version when
+// version is empty
+func waitForAppTile(client *MarketplaceClient, id string, version string, timeout time.Duration) (*AppTileModule, error) {
+	description := fmt.Sprintf("module %s", id)
+	if version != "" {
+		description = fmt.Sprintf("version %s of module %s", version, id)
+	}
+
+	result, err := waitForState(description, WAIT_STATE_MISSING, WAIT_STATE_PRESENT, timeout, func() (interface{}, string, error) {
+		app, err := client.getAppTileModule(id)
+		if isNotFound(err) {
+			return id, WAIT_STATE_MISSING, nil
+		}
+		if err != nil {
+			return nil, "", err
+		}
+		if version != "" && app.Version != version {
+			return id, WAIT_STATE_MISSING, nil
+		}
+		return app, WAIT_STATE_PRESENT, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*AppTileModule), nil
+}
+
+// waitForAppTileDeleted waits until the module can no longer be read
+func waitForAppTileDeleted(client *MarketplaceClient, id string, timeout time.Duration) error {
+	_, err := waitForState(fmt.Sprintf("module %s to be deleted", id), WAIT_STATE_PRESENT, WAIT_STATE_MISSING, timeout, func() (interface{}, string, error) {
+		_, err := client.getAppTileModule(id)
+		if isNotFound(err) {
+			return id, WAIT_STATE_MISSING, nil
+		}
+		if err != nil {
+			return nil, "", err
+		}
+		return id, WAIT_STATE_PRESENT, nil
+	})
+	return err
+}
+
+// waitForInstall waits until an install of a module version that wasn't in before is listed
+func waitForInstall(client *MarketplaceClient, moduleId string, version string, org bool, before []InstallFields, timeout time.Duration) (*InstallFields, error) {
+	description := fmt.Sprintf("the install of version %s of module %s", version, moduleId)
+	result, err := waitForState(description, WAIT_STATE_MISSING, WAIT_STATE_PRESENT, timeout, func() (interface{}, string, error) {
+		installs, err := client.recentInstalls(moduleId, version, org)
+		if err != nil {
+			return nil, "", err
+		}
+		install, err := newInstall(before, installs)
+		if err != nil {
+			return nil, "", err
+		}
+		if install == nil {
+			return moduleId, WAIT_STATE_MISSING, nil
+		}
+		return install, WAIT_STATE_PRESENT, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*InstallFields), nil
+}
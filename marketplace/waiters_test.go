@@ -0,0 +1,126 @@
+package marketplace
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// withFastWaits polls without the usual delay for the rest of the test
+func withFastWaits(t *testing.T) {
+	minTimeout := waitMinTimeout
+	waitMinTimeout = time.Millisecond
+	t.Cleanup(func() { waitMinTimeout = minTimeout })
+}
+
+var moduleNotFound = &gqlerror.Error{Message: "Module not found", Extensions: map[string]interface{}{"code": "NOT_FOUND"}}
+
+// fakeModuleReads answers GetPublishedModule with each response in turn, repeating the last one
+func fakeModuleReads(t *testing.T, reads *int, responses ...interface{}) *MarketplaceClient {
+	return newFakeGraphQLClient(t, func(operation string, variables map[string]interface{}) (interface{}, *gqlerror.Error) {
+		if operation != "GetPublishedModule" {
+			t.Errorf("unexpected operation %s", operation)
+			return nil, &gqlerror.Error{Message: "unexpected operation"}
+		}
+		response := responses[len(responses)-1]
+		if *reads < len(responses) {
+			response = responses[*reads]
+		}
+		*reads++
+		if gqlErr, ok := response.(*gqlerror.Error); ok {
+			return nil, gqlErr
+		}
+		return map[string]interface{}{"myModule": response}, nil
+	})
+}
+
+func TestWaitForAppTile(t *testing.T) {
+	withFastWaits(t)
+	reads := 0
+	client := fakeModuleReads(t, &reads,
+		moduleNotFound,
+		map[string]interface{}{"title": "old", "version": "1.0.0"},
+		map[string]interface{}{"title": "new", "version": "1.1.0"},
+	)
+
+	app, err := waitForAppTile(client, "module", "1.1.0", time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if app.Title != "new" || reads != 3 {
+		t.Errorf("expected version 1.1.0 after 3 reads, got %q after %d", app.Title, reads)
+	}
+}
+
+func TestWaitForAppTileError(t *testing.T) {
+	withFastWaits(t)
+	reads := 0
+	client := fakeModuleReads(t, &reads, moduleNotFound, &gqlerror.Error{Message: "Unauthorized"})
+
+	if _, err := waitForAppTile(client, "module", "", time.Minute); err == nil || !strings.Contains(err.Error(), "Unauthorized") {
+		t.Errorf("expected the read error to stop the wait, got %v", err)
+	}
+	if reads != 2 {
+		t.Errorf("expected 2 reads, got %d", reads)
+	}
+}
+
+func TestWaitForAppTileDeleted(t *testing.T) {
+	withFastWaits(t)
+	reads := 0
+	module := map[string]interface{}{"title": "name", "version": "1.0.0"}
+	client := fakeModuleReads(t, &reads, module, module, moduleNotFound)
+
+	if err := waitForAppTileDeleted(client, "module", time.Minute); err != nil {
+		t.Fatal(err)
+	}
+	if reads != 3 {
+		t.Errorf("expected 3 reads, got %d", reads)
+	}
+}
+
+func TestWaitForAppTileDeletedTimeout(t *testing.T) {
+	withFastWaits(t)
+	reads := 0
+	client := fakeModuleReads(t, &reads, map[string]interface{}{"title": "name", "version": "1.0.0"})
+
+	if err := waitForAppTileDeleted(client, "module", 300*time.Millisecond); err == nil {
+		t.Error("expected a module that is never deleted to time out")
+	}
+}
+
+func TestWaitForInstall(t *testing.T) {
+	withFastWaits(t)
+	install := func(id string, installedOn int64) map[string]interface{} {
+		return map[string]interface{}{"node": map[string]interface{}{
+			"id":          id,
+			"installedOn": installedOn,
+			"module":      map[string]interface{}{"__typename": "MarketplaceModule", "id": "module", "version": "1.0.0"},
+		}}
+	}
+	reads := 0
+	client := newFakeGraphQLClient(t, func(operation string, variables map[string]interface{}) (interface{}, *gqlerror.Error) {
+		if operation != "GetMyInstalls" {
+			t.Errorf("unexpected operation %s", operation)
+			return nil, &gqlerror.Error{Message: "unexpected operation"}
+		}
+		reads++
+		edges := []interface{}{install("old", 100)}
+		if reads > 1 {
+			edges = []interface{}{install("new", 200), install("old", 100)}
+		}
+		return map[string]interface{}{"myInstalls": map[string]interface{}{"edges": edges, "pageInfo": map[string]interface{}{}}}, nil
+	})
+
+	// The update path installs a version that is already installed, which must not be mistaken for the new install
+	before := []InstallFields{{Id: "old", InstalledOn: 100}}
+	result, err := waitForInstall(client, "module", "1.0.0", false, before, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Id != "new" || reads != 2 {
+		t.Errorf("expected the new install after 2 reads, got %s after %d", result.Id, reads)
+	}
+}